@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// defaultAPIVersion is sent as X-Vidos-Api-Version unless api_version is configured.
+const defaultAPIVersion = "1.0"
+
+// resourceAPIVersions is the minimum management API version of each resource type, keyed by
+// type name. Configure warns when the configured version is older. A resource that starts using
+// a newer API raises its entry here.
+var resourceAPIVersions = map[string]string{
+	"vidos_iam_api_key":                         "1.0",
+	"vidos_iam_api_key_policies_exclusive":      "1.0",
+	"vidos_iam_api_key_policy_attachment":       "1.0",
+	"vidos_iam_policy":                          "1.0",
+	"vidos_iam_service_role":                    "1.0",
+	"vidos_iam_service_role_policies_exclusive": "1.0",
+	"vidos_iam_service_role_policy_attachment":  "1.0",
+	"vidos_resolver_configuration":              "1.0",
+	"vidos_resolver_instance":                   "1.0",
+	"vidos_verifier_configuration":              "1.0",
+	"vidos_verifier_instance":                   "1.0",
+	"vidos_validator_configuration":             "1.0",
+	"vidos_validator_instance":                  "1.0",
+	"vidos_authorizer_configuration":            "1.0",
+	"vidos_authorizer_instance":                 "1.0",
+	"vidos_gateway_configuration":               "1.0",
+	"vidos_gateway_instance":                    "1.0",
+}
+
+// apiVersion is a MAJOR.MINOR management API version.
+type apiVersion struct {
+	major, minor int
+}
+
+// parseAPIVersion accepts MAJOR or MAJOR.MINOR; a missing minor version is 0.
+func parseAPIVersion(s string) (apiVersion, error) {
+	s = strings.TrimSpace(s)
+	majorStr, minorStr, hasMinor := strings.Cut(s, ".")
+	major, err := strconv.Atoi(majorStr)
+	if err != nil || major < 0 {
+		return apiVersion{}, fmt.Errorf("%q is not an API version like 1.0", s)
+	}
+	minor := 0
+	if hasMinor {
+		minor, err = strconv.Atoi(minorStr)
+		if err != nil || minor < 0 {
+			return apiVersion{}, fmt.Errorf("%q is not an API version like 1.0", s)
+		}
+	}
+	return apiVersion{major: major, minor: minor}, nil
+}
+
+func (v apiVersion) String() string {
+	return fmt.Sprintf("%d.%d", v.major, v.minor)
+}
+
+func (v apiVersion) less(o apiVersion) bool {
+	if v.major != o.major {
+		return v.major < o.major
+	}
+	return v.minor < o.minor
+}
+
+// apiVersionsResponse is the body of GET /api-versions on the IAM service.
+type apiVersionsResponse struct {
+	MinimumVersion string `json:"minimumVersion"`
+	CurrentVersion string `json:"currentVersion"`
+}
+
+// checkAPIVersion compares the configured API version with the range the server supports.
+// Servers without the versions endpoint only speak defaultAPIVersion.
+func (c *APIClient) checkAPIVersion(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	configured, err := parseAPIVersion(c.apiVersion())
+	if err != nil {
+		diags.AddAttributeError(path.Root("api_version"), "Invalid API version", err.Error())
+		return diags
+	}
+
+	var out apiVersionsResponse
+	found, getDiags := c.doJSONAllowNotFound(ctx, "GET", joinURL(c.iamBaseURL(), "/api-versions"), nil, &out)
+	if getDiags.HasError() {
+		// Not being able to negotiate shouldn't block a run; requests will fail on their own
+		// if the version is really unsupported.
+		diags.AddWarning("Could not check the API version", getDiags.Errors()[0].Detail())
+		return diags
+	}
+	if !found {
+		out = apiVersionsResponse{MinimumVersion: defaultAPIVersion, CurrentVersion: defaultAPIVersion}
+	}
+
+	minimum, minErr := parseAPIVersion(firstNonEmpty(out.MinimumVersion, defaultAPIVersion))
+	current, curErr := parseAPIVersion(out.CurrentVersion)
+	if minErr != nil || curErr != nil {
+		diags.AddWarning("Could not check the API version", fmt.Sprintf("The server reported an unrecognized version range %q..%q.", out.MinimumVersion, out.CurrentVersion))
+		return diags
+	}
+
+	if current.less(configured) {
+		diags.AddAttributeError(path.Root("api_version"), "Unsupported API version",
+			fmt.Sprintf("API version %s is configured, but the server supports at most %s.", configured, current))
+		return diags
+	}
+	if configured.less(minimum) {
+		diags.AddAttributeError(path.Root("api_version"), "Unsupported API version",
+			fmt.Sprintf("API version %s is no longer supported by the server; the minimum is %s.", configured, minimum))
+		return diags
+	}
+
+	typeNames := make([]string, 0, len(resourceAPIVersions))
+	for typeName := range resourceAPIVersions {
+		typeNames = append(typeNames, typeName)
+	}
+	sort.Strings(typeNames)
+	for _, typeName := range typeNames {
+		required, err := parseAPIVersion(resourceAPIVersions[typeName])
+		if err != nil || !configured.less(required) {
+			continue
+		}
+		detail := fmt.Sprintf("%s needs API version %s, but %s is configured. Operations on it will fail.", typeName, required, configured)
+		if !current.less(required) {
+			detail += fmt.Sprintf(" The server supports %s; set api_version to use it.", current)
+		}
+		diags.AddAttributeWarning(path.Root("api_version"), "API version too old for "+typeName, detail)
+	}
+	return diags
+}
+
+// apiVersion returns the version sent with every request.
+func (c *APIClient) apiVersion() string {
+	return firstNonEmpty(c.cfg.apiVersion, defaultAPIVersion)
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseAPIVersion(t *testing.T) {
+	for in, want := range map[string]string{"1": "1.0", "1.2": "1.2", " 2.10 ": "2.10"} {
+		v, err := parseAPIVersion(in)
+		if err != nil || v.String() != want {
+			t.Fatalf("%q: expected %s, got %s (%v)", in, want, v, err)
+		}
+	}
+	for _, in := range []string{"", "v1", "1.x", "-1", "1.-2"} {
+		if _, err := parseAPIVersion(in); err == nil {
+			t.Fatalf("%q: expected error", in)
+		}
+	}
+	if !(apiVersion{1, 9}).less(apiVersion{1, 10}) || (apiVersion{2, 0}).less(apiVersion{1, 10}) {
+		t.Fatalf("expected versions to compare numerically")
+	}
+}
+
+func apiVersionsClient(status int, body string) *APIClient {
+	return newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if r.URL.Path != "/api-versions" {
+			return nil, errors.New("unexpected request " + r.URL.String())
+		}
+		return httpResponse(status, nil, body), nil
+	}))
+}
+
+func TestAPIClient_checkAPIVersion(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured string
+		status     int
+		body       string
+		wantError  string
+		wantWarn   bool
+	}{
+		{name: "default in range", status: 200, body: `{"minimumVersion":"1.0","currentVersion":"1.2"}`},
+		{name: "newer in range", configured: "1.2", status: 200, body: `{"minimumVersion":"1.0","currentVersion":"1.2"}`},
+		{name: "newer than server", configured: "1.3", status: 200, body: `{"minimumVersion":"1.0","currentVersion":"1.2"}`, wantError: "at most 1.2"},
+		{name: "older than minimum", status: 200, body: `{"minimumVersion":"1.1","currentVersion":"1.2"}`, wantError: "minimum is 1.1"},
+		{name: "endpoint missing", status: 404, body: `{"code":"NotFound"}`},
+		{name: "endpoint missing with newer version", configured: "1.1", status: 404, body: `{"code":"NotFound"}`, wantError: "at most 1.0"},
+		{name: "server error only warns", status: 400, body: `{"code":"BadRequest","message":"nope"}`, wantWarn: true},
+		{name: "unrecognized range only warns", status: 200, body: `{"currentVersion":"latest"}`, wantWarn: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := apiVersionsClient(tt.status, tt.body)
+			c.cfg.apiVersion = tt.configured
+
+			diags := c.checkAPIVersion(context.Background())
+			if tt.wantError != "" {
+				if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), tt.wantError) {
+					t.Fatalf("expected error containing %q, got %#v", tt.wantError, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %#v", diags)
+			}
+			if got := diags.WarningsCount() > 0; got != tt.wantWarn {
+				t.Fatalf("expected warning %v, got %#v", tt.wantWarn, diags)
+			}
+		})
+	}
+}
+
+func TestAPIClient_checkAPIVersion_WarnsForResourcesNeedingNewerVersion(t *testing.T) {
+	orig := resourceAPIVersions
+	resourceAPIVersions = map[string]string{"vidos_new_thing": "1.2", "vidos_old_thing": "1.0"}
+	t.Cleanup(func() { resourceAPIVersions = orig })
+
+	c := apiVersionsClient(200, `{"minimumVersion":"1.0","currentVersion":"1.2"}`)
+	diags := c.checkAPIVersion(context.Background())
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("expected one warning, got %#v", diags)
+	}
+	warn := diags.Warnings()[0]
+	if !strings.Contains(warn.Summary(), "vidos_new_thing") || !strings.Contains(warn.Detail(), "set api_version") {
+		t.Fatalf("unexpected warning: %s: %s", warn.Summary(), warn.Detail())
+	}
+
+	c.cfg.apiVersion = "1.2"
+	if diags := c.checkAPIVersion(context.Background()); diags.HasError() || diags.WarningsCount() != 0 {
+		t.Fatalf("expected no warnings at 1.2, got %#v", diags)
+	}
+}
+
+func TestResourceAPIVersions_CoverEveryResource(t *testing.T) {
+	p := New().(*VidosProvider)
+	resources := p.Resources(context.Background())
+	for _, newResource := range resources {
+		var meta resource.MetadataResponse
+		newResource().Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "vidos"}, &meta)
+		raw, ok := resourceAPIVersions[meta.TypeName]
+		if !ok {
+			t.Fatalf("%s has no minimum API version", meta.TypeName)
+		}
+		if _, err := parseAPIVersion(raw); err != nil {
+			t.Fatalf("%s: %s", meta.TypeName, err)
+		}
+	}
+	if len(resourceAPIVersions) != len(resources) {
+		t.Fatalf("expected one entry per resource, got %d entries for %d resources", len(resourceAPIVersions), len(resources))
+	}
+}
+
+func TestAPIClient_doJSON_SendsConfiguredAPIVersion(t *testing.T) {
+	var got []string
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		got = append(got, r.Header.Get("X-Vidos-Api-Version"))
+		return httpResponse(200, nil, `{}`), nil
+	}))
+
+	c.doJSON(context.Background(), "GET", "https://example.com/x", nil, nil)
+	c.cfg.apiVersion = "1.1"
+	c.doJSON(context.Background(), "GET", "https://example.com/x", nil, nil)
+	if len(got) != 2 || got[0] != defaultAPIVersion || got[1] != "1.1" {
+		t.Fatalf("unexpected version headers: %v", got)
+	}
+}
+
+func TestBuildProviderConfig_APIVersion(t *testing.T) {
+	isolateCredentials(t)
+	t.Setenv("VIDOS_API_KEY", "secret")
+	t.Setenv("VIDOS_API_VERSION", "")
+	t.Setenv("VIDOS_SKIP_API_VERSION_CHECK", "")
+
+	cfg, diags := buildProviderConfig(providerModel{})
+	if diags.HasError() || cfg.apiVersion != defaultAPIVersion || cfg.skipAPIVersionCheck {
+		t.Fatalf("expected defaults, got %q %v %#v", cfg.apiVersion, cfg.skipAPIVersionCheck, diags)
+	}
+
+	t.Setenv("VIDOS_API_VERSION", "2")
+	t.Setenv("VIDOS_SKIP_API_VERSION_CHECK", "true")
+	cfg, diags = buildProviderConfig(providerModel{})
+	if diags.HasError() || cfg.apiVersion != "2.0" || !cfg.skipAPIVersionCheck {
+		t.Fatalf("expected env settings, got %q %v %#v", cfg.apiVersion, cfg.skipAPIVersionCheck, diags)
+	}
+
+	cfg, diags = buildProviderConfig(providerModel{ApiVersion: types.StringValue("1.1"), SkipAPIVersionCheck: types.BoolValue(false)})
+	if diags.HasError() || cfg.apiVersion != "1.1" || cfg.skipAPIVersionCheck {
+		t.Fatalf("expected attributes to win, got %q %v %#v", cfg.apiVersion, cfg.skipAPIVersionCheck, diags)
+	}
+
+	if _, diags := buildProviderConfig(providerModel{ApiVersion: types.StringValue("latest")}); !diags.HasError() {
+		t.Fatalf("expected error for invalid api_version")
+	}
+}
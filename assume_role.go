@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// assumeRoleConfig selects the service role whose scoped credentials the provider uses
+// instead of its base API key.
+type assumeRoleConfig struct {
+	serviceRoleID string
+	owner         string
+}
+
+// assumeRoleResponse is the body of POST /service-roles/{id}/assume.
+type assumeRoleResponse struct {
+	Credentials struct {
+		ApiSecret string `json:"apiSecret"`
+		ExpiresAt string `json:"expiresAt"`
+	} `json:"credentials"`
+}
+
+// newAssumedRoleCredentials returns credentials that exchange the base client's API key for
+// scoped service role credentials, again whenever those expire or are rejected.
+func newAssumedRoleCredentials(base *APIClient, role assumeRoleConfig) *cachedCredentials {
+	return newCachedCredentials(func(ctx context.Context) (string, time.Time, error) {
+		return assumeRole(ctx, base, role)
+	})
+}
+
+func assumeRole(ctx context.Context, base *APIClient, role assumeRoleConfig) (string, time.Time, error) {
+	var out assumeRoleResponse
+	assumeURL := joinURLWithQuery(base.iamBaseURL(), fmt.Sprintf("/service-roles/%s/assume", url.PathEscape(role.serviceRoleID)), map[string]string{"resourceOwner": role.owner})
+	if diags := base.doJSON(ctx, "POST", assumeURL, map[string]any{}, &out); diags.HasError() {
+		return "", time.Time{}, fmt.Errorf("assuming service role %q: %s", role.serviceRoleID, diags.Errors()[0].Detail())
+	}
+
+	apiSecret := strings.TrimSpace(out.Credentials.ApiSecret)
+	if apiSecret == "" {
+		return "", time.Time{}, errors.New("assuming service role " + role.serviceRoleID + ": the API returned no credentials")
+	}
+	var expiresAt time.Time
+	if exp := strings.TrimSpace(out.Credentials.ExpiresAt); exp != "" {
+		t, err := time.Parse(time.RFC3339, exp)
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("assuming service role %q: invalid expiresAt: %w", role.serviceRoleID, err)
+		}
+		expiresAt = t
+	}
+	return apiSecret, expiresAt, nil
+}
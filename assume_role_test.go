@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func newAssumeRoleTestClient(rt http.RoundTripper) *APIClient {
+	c := NewAPIClient(providerConfig{
+		domain:        "example.com",
+		defaultRegion: "eu",
+		apiKeySecret:  "bootstrap",
+		assumeRole:    &assumeRoleConfig{serviceRoleID: "deployer", owner: "account"},
+	})
+	c.httpClient.Transport = rt
+	return c
+}
+
+func TestAPIClient_AssumeRole_UsesRoleCredentials(t *testing.T) {
+	var requests []string
+	c := newAssumeRoleTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		requests = append(requests, r.Method+" "+r.URL.String()+" "+r.Header.Get("Authorization"))
+		if strings.HasSuffix(r.URL.Path, "/assume") {
+			return httpResponse(200, nil, `{"credentials":{"apiSecret":"scoped"}}`), nil
+		}
+		return httpResponse(200, nil, `{}`), nil
+	}))
+
+	for i := 0; i < 2; i++ {
+		if diags := c.doJSON(context.Background(), "GET", "https://resolver.management.eu.example.com/instances", nil, nil); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %#v", diags)
+		}
+	}
+
+	want := []string{
+		"POST https://iam.management.global.example.com/service-roles/deployer/assume?resourceOwner=account Bearer bootstrap",
+		"GET https://resolver.management.eu.example.com/instances Bearer scoped",
+		"GET https://resolver.management.eu.example.com/instances Bearer scoped",
+	}
+	if strings.Join(requests, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected requests:\n%s", strings.Join(requests, "\n"))
+	}
+}
+
+func TestAPIClient_AssumeRole_RefreshesOnExpiryAnd401(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	origNow := nowFn
+	nowFn = func() time.Time { return now }
+	t.Cleanup(func() { nowFn = origNow })
+
+	var assumed int
+	var auths []string
+	c := newAssumeRoleTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if strings.HasSuffix(r.URL.Path, "/assume") {
+			assumed++
+			return httpResponse(200, nil, `{"credentials":{"apiSecret":"scoped-`+string(rune('0'+assumed))+`","expiresAt":"2026-01-01T13:00:00Z"}}`), nil
+		}
+		auths = append(auths, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") == "Bearer scoped-2" {
+			return httpResponse(401, nil, `{"code":"Unauthorized","message":"revoked"}`), nil
+		}
+		return httpResponse(200, nil, `{}`), nil
+	}))
+
+	get := func() {
+		t.Helper()
+		if diags := c.doJSON(context.Background(), "GET", "https://example.com/x", nil, nil); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %#v", diags)
+		}
+	}
+	get()
+	now = now.Add(time.Hour)
+	get()
+
+	want := "Bearer scoped-1,Bearer scoped-2,Bearer scoped-3"
+	if strings.Join(auths, ",") != want || assumed != 3 {
+		t.Fatalf("expected re-assume on expiry and on 401, got %v (%d assumes)", auths, assumed)
+	}
+}
+
+func TestAPIClient_AssumeRole_Failure(t *testing.T) {
+	c := newAssumeRoleTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if !strings.HasSuffix(r.URL.Path, "/assume") {
+			t.Fatalf("no request expected without role credentials: %s", r.URL)
+		}
+		return httpResponse(403, nil, `{"code":"Forbidden","message":"not allowed to assume role"}`), nil
+	}))
+
+	diags := c.doJSON(context.Background(), "GET", "https://example.com/x", nil, nil)
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), `assuming service role "deployer"`) ||
+		!strings.Contains(diags.Errors()[0].Detail(), "not allowed to assume role") {
+		t.Fatalf("expected assume role error, got %#v", diags)
+	}
+}
+
+func TestBuildProviderConfig_AssumeRole(t *testing.T) {
+	isolateCredentials(t)
+	t.Setenv("VIDOS_API_KEY", "secret")
+
+	cfg, diags := buildProviderConfig(providerModel{AssumeRole: &providerAssumeRoleModel{ServiceRoleID: types.StringValue("deployer"), Owner: types.StringNull()}})
+	if diags.HasError() || cfg.assumeRole == nil || *cfg.assumeRole != (assumeRoleConfig{serviceRoleID: "deployer", owner: "account"}) {
+		t.Fatalf("expected assume role with default owner, got %#v %#v", cfg.assumeRole, diags)
+	}
+
+	cfg, diags = buildProviderConfig(providerModel{AssumeRole: &providerAssumeRoleModel{ServiceRoleID: types.StringValue("deployer"), Owner: types.StringValue("managed")}})
+	if diags.HasError() || cfg.assumeRole.owner != "managed" {
+		t.Fatalf("expected managed owner, got %#v %#v", cfg.assumeRole, diags)
+	}
+
+	if cfg, _ := buildProviderConfig(providerModel{}); cfg.assumeRole != nil {
+		t.Fatalf("expected no assume role without the block")
+	}
+
+	_, diags = buildProviderConfig(providerModel{AssumeRole: &providerAssumeRoleModel{ServiceRoleID: types.StringNull(), Owner: types.StringNull()}})
+	if !diags.HasError() {
+		t.Fatalf("expected error without service_role_id")
+	}
+	withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+	if !ok || !withPath.Path().Equal(path.Root("assume_role").AtName("service_role_id")) {
+		t.Fatalf("expected error on assume_role.service_role_id, got %#v", diags)
+	}
+}
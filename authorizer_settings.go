@@ -0,0 +1,38 @@
+package main
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// authorizerSettingsSchemaAttribute is the typed alternative to the authorizer configuration JSON:
+//
+//	{"policies": {"validate": {"skip", "validator": {"type", "resourceId", "serviceRole"}}}}
+func authorizerSettingsSchemaAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"policies": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Authorization policies.",
+				Attributes: map[string]schema.Attribute{
+					"validate": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "Credential validation policy.",
+						Attributes: map[string]schema.Attribute{
+							"skip": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether the policy is skipped.",
+							},
+							"validator": schema.SingleNestedAttribute{
+								Optional:    true,
+								Description: "Validator instance credentials are checked against.",
+								Attributes:  instanceReferenceSettingsAttributes(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
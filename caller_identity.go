@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// callerIdentity describes who the provider's API key belongs to.
+type callerIdentity struct {
+	AccountID string `json:"accountId"`
+	ApiKeyID  string `json:"apiKeyId"`
+}
+
+type callerIdentityResponse struct {
+	CallerIdentity callerIdentity `json:"callerIdentity"`
+}
+
+// callerIdentity returns the identity behind the API key, asking the IAM service once and
+// reusing the answer for the rest of the run.
+func (c *APIClient) callerIdentity(ctx context.Context) (callerIdentity, diag.Diagnostics) {
+	c.identityMu.Lock()
+	defer c.identityMu.Unlock()
+	if c.identity != nil {
+		return *c.identity, nil
+	}
+
+	var diags diag.Diagnostics
+	var out callerIdentityResponse
+	_, status, getDiags := c.doJSONInternal(ctx, "GET", joinURL(c.iamBaseURL(), "/whoami"), nil, &out, false)
+	switch {
+	case status == 401:
+		diags.AddError("Invalid credentials",
+			"The API key was rejected by the Vidos API. Check that it is correct and has not expired or been deleted. "+
+				"Set skip_credentials_validation to defer this check to the first API call.")
+		return callerIdentity{}, diags
+	case status == 403:
+		diags.AddError("Insufficient permissions",
+			"The API key is valid but may not look up its own identity (GET /whoami). "+
+				"Allow it in the key's policy, or set skip_credentials_validation to skip the check.")
+		return callerIdentity{}, diags
+	case getDiags.HasError():
+		return callerIdentity{}, getDiags
+	}
+	if out.CallerIdentity.AccountID == "" {
+		diags.AddError("Invalid caller identity", fmt.Sprintf("GET %s returned no account ID.", joinURL(c.iamBaseURL(), "/whoami")))
+		return callerIdentity{}, diags
+	}
+
+	c.identity = &out.CallerIdentity
+	return out.CallerIdentity, diags
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAPIClient_callerIdentity_CachesResult(t *testing.T) {
+	var calls int
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		if got := r.URL.String(); got != "https://iam.management.global.example.com/whoami" {
+			return httpResponse(500, nil, "unexpected url: "+got), nil
+		}
+		return httpResponse(200, nil, `{"callerIdentity":{"accountId":"acc","apiKeyId":"key"}}`), nil
+	}))
+
+	for i := 0; i < 2; i++ {
+		identity, diags := c.callerIdentity(context.Background())
+		if diags.HasError() || identity != (callerIdentity{AccountID: "acc", ApiKeyID: "key"}) {
+			t.Fatalf("unexpected identity: %#v %#v", identity, diags)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected identity to be looked up once, got %d calls", calls)
+	}
+}
+
+func TestAPIClient_callerIdentity_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantSummary string
+	}{
+		{"rejected key", 401, `{"code":"Unauthorized","message":"expired"}`, "Invalid credentials"},
+		{"not allowed", 403, `{"code":"Forbidden","message":"denied"}`, "Insufficient permissions"},
+		{"other error", 400, `{"code":"BadRequest","message":"nope"}`, "API error"},
+		{"no account", 200, `{"callerIdentity":{}}`, "Invalid caller identity"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+				return httpResponse(tt.status, nil, tt.body), nil
+			}))
+			_, diags := c.callerIdentity(context.Background())
+			if !diags.HasError() || diags.Errors()[0].Summary() != tt.wantSummary {
+				t.Fatalf("expected %q, got %#v", tt.wantSummary, diags)
+			}
+			if c.identity != nil {
+				t.Fatalf("expected failed lookup not to be cached")
+			}
+		})
+	}
+}
+
+func TestCallerIdentityDataSource_Read(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return httpResponse(200, nil, `{"callerIdentity":{"accountId":"acc","apiKeyId":"key"}}`), nil
+	}))
+	d := &CallerIdentityDataSource{}
+	d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: nil}, &datasource.ConfigureResponse{})
+	d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: c}, &datasource.ConfigureResponse{})
+
+	ctx := context.Background()
+	var sch datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &sch)
+	var resp datasource.ReadResponse
+	initDataSourceState(t, &resp.State, sch.Schema)
+	d.Read(ctx, datasource.ReadRequest{Config: dataSourceConfig(t, sch.Schema, nil)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+
+	var got callerIdentityDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if got.AccountID.ValueString() != "acc" || got.ApiKeyID.ValueString() != "key" {
+		t.Fatalf("unexpected state: %#v", got)
+	}
+}
+
+func TestProviderConfigure_RejectsInvalidCredentials(t *testing.T) {
+	isolateCredentials(t)
+	t.Setenv("VIDOS_SKIP_API_VERSION_CHECK", "true")
+	t.Setenv("VIDOS_SKIP_CREDENTIALS_VALIDATION", "")
+	orig := http.DefaultTransport
+	http.DefaultTransport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if r.URL.Path != "/whoami" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.String())
+		}
+		return httpResponse(401, nil, `{"code":"Unauthorized","message":"invalid api key"}`), nil
+	})
+	t.Cleanup(func() { http.DefaultTransport = orig })
+
+	ctx := context.Background()
+	p := &VidosProvider{version: "test"}
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	configure := func(skip types.Bool) provider.ConfigureResponse {
+		t.Helper()
+		skipTF, err := skip.ToTerraformValue(ctx)
+		if err != nil {
+			t.Fatalf("ToTerraformValue error: %s", err)
+		}
+		cfg := tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: objectValue(schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object), map[string]tftypes.Value{
+				"api_key":                     tftypes.NewValue(tftypes.String, "bad"),
+				"skip_credentials_validation": skipTF,
+			}),
+		}
+		var resp provider.ConfigureResponse
+		p.Configure(ctx, provider.ConfigureRequest{Config: cfg}, &resp)
+		return resp
+	}
+
+	resp := configure(types.BoolNull())
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Summary(), "Invalid credentials") {
+		t.Fatalf("expected invalid credentials error, got %#v", resp.Diagnostics)
+	}
+	if resp.ResourceData != nil {
+		t.Fatalf("expected provider data unset on error")
+	}
+
+	if resp := configure(types.BoolValue(true)); resp.Diagnostics.HasError() || resp.ResourceData == nil {
+		t.Fatalf("expected skip_credentials_validation to skip the check, got %#v", resp.Diagnostics)
+	}
+}
@@ -4,13 +4,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"math/rand/v2"
 	"net/http"
 	"net/url"
-	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -20,6 +20,15 @@ import (
 type APIClient struct {
 	httpClient *http.Client
 	cfg        providerConfig
+	// credentials supplies the API key when credential_process or assume_role is configured;
+	// otherwise cfg.apiKeySecret is used for every request.
+	credentials *cachedCredentials
+	// limiter paces requests per host; nil disables limiting.
+	limiter *requestLimiter
+
+	identityMu sync.Mutex
+	// identity caches the caller identity once it has been looked up.
+	identity *callerIdentity
 }
 
 // sleepFn exists to make retry behavior unit-testable without real delays.
@@ -30,11 +39,73 @@ var sleepFn = time.Sleep
 // Production code uses time.Now.
 var nowFn = time.Now
 
+// defaultRequestTimeout bounds a single HTTP attempt, so a hung connection is retried instead
+// of using up the whole operation deadline from the resource's timeouts block.
+const defaultRequestTimeout = 30 * time.Second
+
+// defaultMaxAttempts bounds request retries when max_retries is not set, and polling loops
+// when the caller's context has no deadline.
+const defaultMaxAttempts = 5
+
+// Default exponential backoff bounds; the provider's retry settings override them.
+const (
+	defaultRetryMinBackoff = 250 * time.Millisecond
+	defaultRetryMaxBackoff = 5 * time.Second
+)
+
+// retryableStatusCodes are retried for every method: the request was throttled or never
+// reached a healthy backend.
+var retryableStatusCodes = []int{429, 502, 503, 504}
+
 func NewAPIClient(cfg providerConfig) *APIClient {
-	return &APIClient{
-		httpClient: &http.Client{Timeout: 30 * time.Second},
+	c := &APIClient{
+		httpClient: &http.Client{},
 		cfg:        cfg,
+		limiter:    newRequestLimiter(cfg.maxRequestsPerSecond, cfg.maxConcurrentRequests),
+	}
+	if cfg.httpTransport != nil {
+		c.httpClient.Transport = cfg.httpTransport
+	}
+	if cfg.credentialProcess != "" {
+		c.credentials = newProcessCredentials(cfg.credentialProcess)
+	}
+	if cfg.assumeRole != nil {
+		// The base client authenticates the exchange with the configured key; every other
+		// request uses the role's credentials.
+		base := &APIClient{httpClient: c.httpClient, cfg: cfg, credentials: c.credentials, limiter: c.limiter}
+		c.credentials = newAssumedRoleCredentials(base, *cfg.assumeRole)
+	}
+	return c
+}
+
+// apiKey returns the API key to send with the next request.
+func (c *APIClient) apiKey(ctx context.Context) (string, error) {
+	if c.credentials == nil {
+		return c.cfg.apiKeySecret, nil
+	}
+	return c.credentials.get(ctx)
+}
+
+// attemptsRemain reports whether a retry or polling loop may start the given attempt. Loops
+// run until the context deadline when one is set; otherwise fallbackMax attempts are allowed.
+func attemptsRemain(ctx context.Context, attempt, fallbackMax int) bool {
+	if ctx.Err() != nil {
+		return false
 	}
+	if _, ok := ctx.Deadline(); ok {
+		return true
+	}
+	return attempt <= fallbackMax
+}
+
+// requestContext bounds a single attempt by defaultRequestTimeout, or by the operation deadline
+// when that is sooner.
+func requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout := defaultRequestTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = min(timeout, time.Until(deadline))
+	}
+	return context.WithTimeout(ctx, timeout)
 }
 
 type friendlyError struct {
@@ -69,28 +140,46 @@ func (e apiError) Error() string {
 	return fmt.Sprintf("%s %s failed: status=%d", e.Method, e.URL, e.StatusCode)
 }
 
+// managementBaseURL returns the configured endpoint override for service, or the standard
+// management URL for region under the configured domain.
+func (c *APIClient) managementBaseURL(service, region string) string {
+	if endpoint := c.cfg.endpoints[service]; endpoint != "" {
+		return endpoint
+	}
+	return buildManagementBaseURL(service, region, c.cfg.domain)
+}
+
+// regionalBaseURL returns the base URL of a regional service in region, or in the provider
+// region when region is empty.
+func (c *APIClient) regionalBaseURL(service, region string) string {
+	if region == "" {
+		region = c.cfg.defaultRegion
+	}
+	return c.managementBaseURL(service, region)
+}
+
 func (c *APIClient) iamBaseURL() string {
-	return buildManagementBaseURL("iam", "global", c.cfg.domain)
+	return c.managementBaseURL("iam", "global")
 }
 
 func (c *APIClient) resolverBaseURL() string {
-	return buildManagementBaseURL("resolver", c.cfg.defaultRegion, c.cfg.domain)
+	return c.regionalBaseURL("resolver", "")
 }
 
 func (c *APIClient) verifierBaseURL() string {
-	return buildManagementBaseURL("verifier", c.cfg.defaultRegion, c.cfg.domain)
+	return c.regionalBaseURL("verifier", "")
 }
 
 func (c *APIClient) validatorBaseURL() string {
-	return buildManagementBaseURL("validator", c.cfg.defaultRegion, c.cfg.domain)
+	return c.regionalBaseURL("validator", "")
 }
 
 func (c *APIClient) authorizerBaseURL() string {
-	return buildManagementBaseURL("authorizer", c.cfg.defaultRegion, c.cfg.domain)
+	return c.regionalBaseURL("authorizer", "")
 }
 
 func (c *APIClient) gatewayBaseURL() string {
-	return buildManagementBaseURL("gateway", c.cfg.defaultRegion, c.cfg.domain)
+	return c.regionalBaseURL("gateway", "")
 }
 
 func (c *APIClient) doJSON(ctx context.Context, method, rawURL string, in any, out any) diag.Diagnostics {
@@ -122,44 +211,80 @@ func (c *APIClient) doJSONInternal(ctx context.Context, method, rawURL string, i
 		bodyBytes = b
 	}
 
-	const maxAttempts = 5
-	for attempt := 1; attempt <= maxAttempts; attempt++ {
+	policy := c.cfg.retry
+	// A rejected key from credential_process or assume_role is refreshed and the request
+	// retried once.
+	refreshedCredentials := false
+	for attempt := 1; ; attempt++ {
+		apiKey, err := c.apiKey(ctx)
+		if err != nil {
+			diags.AddError("Credential error", err.Error())
+			return false, 0, diags
+		}
+
 		var body io.Reader
 		if bodyBytes != nil {
 			body = bytes.NewReader(bodyBytes)
 		}
 
-		req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+		// Waiting for the limiter is bounded by the operation deadline only; the attempt's
+		// timeout starts once the request can be sent.
+		release, err := c.limiter.acquire(ctx, u.Host)
 		if err != nil {
+			diags.AddError("Operation timed out", fmt.Sprintf("%s %s was not sent before the operation deadline: %s. "+
+				"Raise max_requests_per_second or max_concurrent_requests, or increase the resource's timeouts block.", method, u.String(), err.Error()))
+			return false, 0, diags
+		}
+
+		reqCtx, cancel := requestContext(ctx)
+		req, err := http.NewRequestWithContext(reqCtx, method, u.String(), body)
+		if err != nil {
+			release()
+			cancel()
 			diags.AddError("Request build error", err.Error())
 			return false, 0, diags
 		}
-		req.Header.Set("Authorization", "Bearer "+c.cfg.apiKeySecret)
+		req.Header.Set("Authorization", "Bearer "+apiKey)
 		req.Header.Set("Accept", "application/json")
 		req.Header.Set("User-Agent", "terraform-provider-vidos")
-		req.Header.Set("X-Vidos-Api-Version", "1.0")
+		req.Header.Set("X-Vidos-Api-Version", c.apiVersion())
 		if bodyBytes != nil {
 			req.Header.Set("Content-Type", "application/json")
 		}
 
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
-			if attempt < maxAttempts {
-				if sleep, ok := retrySleep(ctx, attempt, "", time.Time{}); ok {
+			release()
+			cancel()
+			if policy.attemptsRemain(ctx, attempt+1) {
+				if sleep, ok := policy.sleep(ctx, attempt, "", time.Time{}); ok {
 					sleepFn(sleep)
 					continue
 				}
 			}
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				diags.AddError("Operation timed out", fmt.Sprintf("%s %s did not complete before the operation deadline: %s. "+
+					"Increase the resource's timeouts block if the region is slow to respond.", method, u.String(), err.Error()))
+				return false, 0, diags
+			}
 			diags.AddError("Request error", err.Error())
 			return false, 0, diags
 		}
 		respBody, _ := io.ReadAll(resp.Body)
 		resp.Body.Close()
+		release()
+		cancel()
 
 		if resp.StatusCode < 200 || resp.StatusCode > 299 {
 			if allowNotFound && resp.StatusCode == 404 {
 				return false, resp.StatusCode, diags
 			}
+			if resp.StatusCode == 401 && c.credentials != nil && !refreshedCredentials {
+				tflog.Debug(ctx, "API key rejected, refreshing credentials", map[string]any{"url": u.String()})
+				c.credentials.invalidate(apiKey)
+				refreshedCredentials = true
+				continue
+			}
 
 			var fe friendlyError
 			_ = json.Unmarshal(respBody, &fe)
@@ -168,10 +293,13 @@ func (c *APIClient) doJSONInternal(ctx context.Context, method, rawURL string, i
 				fe = friendlyError{}
 			}
 
-			retryable := resp.StatusCode == 429 || resp.StatusCode == 502 || resp.StatusCode == 503 || resp.StatusCode == 504
-			if retryable && attempt < maxAttempts {
-				retryAfter := resp.Header.Get("Retry-After")
-				if sleep, ok := retrySleep(ctx, attempt, retryAfter, nowFn()); ok {
+			retryAfter := resp.Header.Get("Retry-After")
+			if d, ok := parseRetryAfter(retryAfter, nowFn()); ok && (resp.StatusCode == 429 || resp.StatusCode == 503) {
+				// Hold back every request to this host, not just this one's retry.
+				c.limiter.pause(u.Host, nowFn().Add(d))
+			}
+			if policy.retryable(method, resp.StatusCode) && policy.attemptsRemain(ctx, attempt+1) {
+				if sleep, ok := policy.sleep(ctx, attempt, retryAfter, nowFn()); ok {
 					tflog.Debug(ctx, "Retrying request", map[string]any{"attempt": attempt, "status": resp.StatusCode, "sleep": sleep.String(), "url": u.String()})
 					sleepFn(sleep)
 					continue
@@ -198,8 +326,51 @@ func (c *APIClient) doJSONInternal(ctx context.Context, method, rawURL string, i
 
 		return true, resp.StatusCode, diags
 	}
+}
+
+// maxListPages bounds pagination so a misbehaving server can't keep Terraform looping forever.
+const maxListPages = 100
+
+// listAllPages follows nextToken pagination on a GET list endpoint and collects the array
+// stored under itemsKey on every page.
+func listAllPages[T any](ctx context.Context, c *APIClient, baseURL, urlPath, itemsKey string) ([]T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	items := []T{}
+	token := ""
+	for page := 1; page <= maxListPages; page++ {
+		var out map[string]json.RawMessage
+		listURL := joinURLWithQuery(baseURL, urlPath, map[string]string{"nextToken": token})
+		diags.Append(c.doJSON(ctx, "GET", listURL, nil, &out)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if raw, ok := out[itemsKey]; ok && len(raw) > 0 && string(raw) != "null" {
+			var pageItems []T
+			if err := json.Unmarshal(raw, &pageItems); err != nil {
+				diags.AddError("JSON decode error", fmt.Sprintf("%s: %s", itemsKey, err.Error()))
+				return nil, diags
+			}
+			items = append(items, pageItems...)
+		}
 
-	return false, 0, diags
+		var next string
+		if raw, ok := out["nextToken"]; ok {
+			if err := json.Unmarshal(raw, &next); err != nil {
+				diags.AddError("JSON decode error", fmt.Sprintf("nextToken: %s", err.Error()))
+				return nil, diags
+			}
+		}
+		next = strings.TrimSpace(next)
+		if next == "" || next == token {
+			return items, diags
+		}
+		token = next
+	}
+
+	diags.AddError("Too many pages", fmt.Sprintf("GET %s returned more than %d pages", joinURL(baseURL, urlPath), maxListPages))
+	return nil, diags
 }
 
 func truncateForError(s string, max int) string {
@@ -210,35 +381,8 @@ func truncateForError(s string, max int) string {
 	return s[:max] + "…"
 }
 
+// retrySleep returns how long to wait before the next attempt under the default retry
+// policy, or false when the caller should stop.
 func retrySleep(ctx context.Context, attempt int, retryAfter string, now time.Time) (time.Duration, bool) {
-	if ctx.Err() != nil {
-		return 0, false
-	}
-	if retryAfter != "" {
-		if secs, err := strconv.Atoi(strings.TrimSpace(retryAfter)); err == nil && secs > 0 {
-			return time.Duration(secs) * time.Second, true
-		}
-		if t, err := http.ParseTime(retryAfter); err == nil && !t.IsZero() && !now.IsZero() {
-			d := t.Sub(now)
-			if d > 0 {
-				return d, true
-			}
-		}
-	}
-
-	// Exponential backoff with jitter. Capped to keep Terraform responsive.
-	base := 250 * time.Millisecond
-	max := 5 * time.Second
-	// attempt starts at 1
-	sleep := base * time.Duration(1<<(attempt-1))
-	if sleep > max {
-		sleep = max
-	}
-	// jitter in [0.5, 1.5)
-	jitter := 0.5 + rand.Float64()
-	sleep = time.Duration(float64(sleep) * jitter)
-	if sleep < 50*time.Millisecond {
-		sleep = 50 * time.Millisecond
-	}
-	return sleep, true
+	return retryPolicy{}.sleep(ctx, attempt, retryAfter, now)
 }
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// credentialExpiryWindow refreshes a credential shortly before it expires so a request
+// started just before expiry doesn't go out with a stale key.
+const credentialExpiryWindow = time.Minute
+
+// cachedCredentials caches an API key obtained from fetch and fetches a new one once the key
+// expires or is rejected. It is shared by every request the provider makes, so access is
+// serialized.
+type cachedCredentials struct {
+	// fetch returns a key and its expiry; a zero expiry means the key does not expire.
+	fetch func(ctx context.Context) (string, time.Time, error)
+
+	mu     sync.Mutex
+	apiKey string
+	// refreshAt is when the key is next fetched; zero means never.
+	refreshAt time.Time
+}
+
+func newCachedCredentials(fetch func(ctx context.Context) (string, time.Time, error)) *cachedCredentials {
+	return &cachedCredentials{fetch: fetch}
+}
+
+// get returns the cached key, fetching one first when there is no usable key.
+func (c *cachedCredentials) get(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := nowFn()
+	if c.apiKey != "" && (c.refreshAt.IsZero() || now.Before(c.refreshAt)) {
+		return c.apiKey, nil
+	}
+
+	apiKey, expiresAt, err := c.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+	c.apiKey = apiKey
+	c.refreshAt = time.Time{}
+	if !expiresAt.IsZero() {
+		c.refreshAt = expiresAt.Add(-credentialExpiryWindow)
+		// A key that is already inside the window is used until it expires; fetching again
+		// would run the process on every request.
+		if !now.Before(c.refreshAt) {
+			c.refreshAt = expiresAt
+		}
+	}
+	return apiKey, nil
+}
+
+// invalidate drops apiKey after the API rejected it. Concurrent requests that failed with
+// the same key only cause one refresh.
+func (c *cachedCredentials) invalidate(apiKey string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.apiKey == apiKey {
+		c.apiKey = ""
+		c.refreshAt = time.Time{}
+	}
+}
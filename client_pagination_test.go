@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestListAllPages_FollowsNextToken(t *testing.T) {
+	var urls []string
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		urls = append(urls, r.URL.String())
+		switch r.URL.Query().Get("nextToken") {
+		case "":
+			return httpResponse(200, nil, `{"instances":[{"resourceId":"a"}],"nextToken":"p2"}`), nil
+		case "p2":
+			return httpResponse(200, nil, `{"instances":[{"resourceId":"b"},{"resourceId":"c"}]}`), nil
+		default:
+			return httpResponse(500, nil, "unexpected"), nil
+		}
+	}))
+
+	items, diags := listInstances(context.Background(), c, "https://example.com")
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", diags)
+	}
+	if len(items) != 3 || items[0].ResourceID != "a" || items[2].ResourceID != "c" {
+		t.Fatalf("unexpected items: %+v", items)
+	}
+	if len(urls) != 2 || urls[0] != "https://example.com/instances" || urls[1] != "https://example.com/instances?nextToken=p2" {
+		t.Fatalf("unexpected urls: %v", urls)
+	}
+}
+
+func TestListAllPages_StopsOnRepeatedToken(t *testing.T) {
+	var calls int
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		return httpResponse(200, nil, `{"configurations":[{"resourceId":"a"}],"nextToken":"same"}`), nil
+	}))
+
+	items, diags := listConfigurations(context.Background(), c, "https://example.com")
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", diags)
+	}
+	if calls != 2 || len(items) != 2 {
+		t.Fatalf("expected 2 pages, got calls=%d items=%d", calls, len(items))
+	}
+}
+
+func TestListAllPages_MissingOrNullItemsKey(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return httpResponse(200, nil, `{"instances":null}`), nil
+	}))
+
+	items, diags := listInstances(context.Background(), c, "https://example.com")
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", diags)
+	}
+	if items == nil || len(items) != 0 {
+		t.Fatalf("expected empty non-nil items, got %#v", items)
+	}
+}
+
+func TestListAllPages_DecodeError(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return httpResponse(200, nil, `{"instances":{"not":"a list"}}`), nil
+	}))
+
+	_, diags := listInstances(context.Background(), c, "https://example.com")
+	if !diags.HasError() {
+		t.Fatalf("expected error diagnostics")
+	}
+}
+
+func TestListAllPages_TokenDecodeError(t *testing.T) {
+	var calls int
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		return httpResponse(200, nil, `{"instances":[{"resourceId":"a"}],"nextToken":42}`), nil
+	}))
+
+	_, diags := listInstances(context.Background(), c, "https://example.com")
+	if !diags.HasError() || !strings.HasPrefix(diags.Errors()[0].Detail(), "nextToken: ") {
+		t.Fatalf("expected nextToken decode error, got %#v", diags)
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 call, got %d", calls)
+	}
+}
+
+func TestListAllPages_APIError(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return httpResponse(403, nil, `{"code":"Forbidden","message":"no"}`), nil
+	}))
+
+	_, diags := listInstances(context.Background(), c, "https://example.com")
+	if !diags.HasError() {
+		t.Fatalf("expected error diagnostics")
+	}
+}
+
+func TestListAllPages_TooManyPages(t *testing.T) {
+	var calls int
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		return httpResponse(200, nil, `{"instances":[],"nextToken":"t`+r.URL.Query().Get("nextToken")+`x"}`), nil
+	}))
+
+	_, diags := listInstances(context.Background(), c, "https://example.com")
+	if !diags.HasError() {
+		t.Fatalf("expected error diagnostics")
+	}
+	if calls != maxListPages {
+		t.Fatalf("expected %d calls, got %d", maxListPages, calls)
+	}
+}
@@ -67,3 +67,28 @@ func TestRetrySleep_CapsAtMaxAndHasFloor(t *testing.T) {
 		t.Fatalf("expected floor at 50ms, got %s", d)
 	}
 }
+
+func TestRetrySleep_LargeAttemptStaysAtCap(t *testing.T) {
+	// Long-running waiters keep incrementing attempt; backoff must not collapse to the floor.
+	for _, attempt := range []int{64, 65, 1000} {
+		d, ok := retrySleep(context.Background(), attempt, "", time.Now())
+		if !ok {
+			t.Fatalf("expected ok=true")
+		}
+		if d < 2*time.Second || d > 5*time.Second {
+			t.Fatalf("unexpected duration for attempt %d: %s", attempt, d)
+		}
+	}
+}
+
+func TestRetrySleep_StopsWhenSleepWouldPassDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if _, ok := retrySleep(ctx, 1, "30", time.Now()); ok {
+		t.Fatalf("expected ok=false when Retry-After exceeds the deadline")
+	}
+	if _, ok := retrySleep(ctx, 1, "", time.Now()); !ok {
+		t.Fatalf("expected ok=true for a short backoff within the deadline")
+	}
+}
@@ -31,8 +31,53 @@ func TestNewAPIClient_Defaults(t *testing.T) {
 	if c.httpClient == nil {
 		t.Fatalf("expected http client")
 	}
-	if c.httpClient.Timeout != 30*time.Second {
-		t.Fatalf("unexpected timeout: %s", c.httpClient.Timeout)
+	// Requests are bounded per attempt by requestContext or by the operation deadline.
+	if c.httpClient.Timeout != 0 {
+		t.Fatalf("unexpected client-wide timeout: %s", c.httpClient.Timeout)
+	}
+}
+
+func TestRequestContext_CapsEachAttempt(t *testing.T) {
+	ctx, cancel := requestContext(context.Background())
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	if !ok || time.Until(deadline) > defaultRequestTimeout {
+		t.Fatalf("expected default request deadline, got %v/%v", deadline, ok)
+	}
+
+	// A long operation deadline doesn't lift the per-attempt cap.
+	parent, parentCancel := context.WithTimeout(context.Background(), 20*time.Minute)
+	defer parentCancel()
+	ctx, cancel = requestContext(parent)
+	defer cancel()
+	deadline, ok = ctx.Deadline()
+	if !ok || time.Until(deadline) > defaultRequestTimeout {
+		t.Fatalf("expected attempt deadline within %s, got %v/%v", defaultRequestTimeout, deadline, ok)
+	}
+
+	// A sooner operation deadline is kept.
+	parent, parentCancel = context.WithTimeout(context.Background(), time.Second)
+	defer parentCancel()
+	parentDeadline, _ := parent.Deadline()
+	ctx, cancel = requestContext(parent)
+	defer cancel()
+	if deadline, ok = ctx.Deadline(); !ok || deadline.After(parentDeadline) {
+		t.Fatalf("expected operation deadline, got %v/%v", deadline, ok)
+	}
+}
+
+func TestAttemptsRemain(t *testing.T) {
+	if !attemptsRemain(context.Background(), 5, 5) || attemptsRemain(context.Background(), 6, 5) {
+		t.Fatalf("expected fallback attempt limit without deadline")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	if !attemptsRemain(ctx, 1000, 5) {
+		t.Fatalf("expected deadline to bound attempts instead of the fallback")
+	}
+	cancel()
+	if attemptsRemain(ctx, 1, 5) {
+		t.Fatalf("expected no attempts after the context is done")
 	}
 }
 
@@ -128,6 +173,42 @@ func TestAPIClient_doJSONInternal_NetworkError(t *testing.T) {
 	}
 }
 
+func TestAPIClient_doJSONInternal_AttemptCapAppliesUnderDeadline(t *testing.T) {
+	var calls int
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		return httpResponse(503, nil, `{"code":"Unavailable","message":"busy"}`), nil
+	}))
+
+	oldSleep := sleepFn
+	sleepFn = func(time.Duration) {}
+	defer func() { sleepFn = oldSleep }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	_, _, diags := c.doJSONInternal(ctx, "GET", "https://example.com/test", nil, nil, false)
+	if !diags.HasError() {
+		t.Fatalf("expected error diagnostics")
+	}
+	if calls != defaultMaxAttempts {
+		t.Fatalf("expected %d attempts under a long deadline, got %d", defaultMaxAttempts, calls)
+	}
+}
+
+func TestAPIClient_doJSONInternal_DeadlineExceededReportsTimeout(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		<-r.Context().Done()
+		return nil, r.Context().Err()
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, diags := c.doJSONInternal(ctx, "GET", "https://example.com/test", nil, nil, false)
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Operation timed out" {
+		t.Fatalf("expected timeout diagnostics, got %#v", diags)
+	}
+}
+
 func TestAPIClient_doJSONInternal_Non2xxFriendlyError(t *testing.T) {
 	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
 		return httpResponse(400, nil, `{"code":"BadThing","message":"nope"}`), nil
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// runCredentialProcessFn exists to make credential_process handling testable without
+// spawning commands. Production code uses runCredentialProcess.
+var runCredentialProcessFn = runCredentialProcess
+
+// credentialProcessTimeout bounds a single run of the credential process.
+const credentialProcessTimeout = time.Minute
+
+// credentialProcessOutput is the JSON document the credential process prints on stdout:
+//
+//	{"api_key": "...", "expiration": "2026-01-02T15:04:05Z"}
+//
+// expiration is optional; without it the key is kept until the API rejects it.
+type credentialProcessOutput struct {
+	ApiKey     string `json:"api_key"`
+	Expiration string `json:"expiration"`
+}
+
+// runCredentialProcess runs command through the platform shell and returns its stdout.
+func runCredentialProcess(ctx context.Context, command string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, credentialProcessTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "/bin/sh", "-c", command)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%w: %s", err, truncateForError(msg, 1024))
+		}
+		return nil, err
+	}
+	return stdout.Bytes(), nil
+}
+
+// parseCredentialProcessOutput decodes the process output. A zero expiry means the key
+// does not expire.
+func parseCredentialProcessOutput(b []byte) (string, time.Time, error) {
+	var out credentialProcessOutput
+	if err := json.Unmarshal(b, &out); err != nil {
+		return "", time.Time{}, fmt.Errorf("output is not a JSON credential document: %w", err)
+	}
+	apiKey := strings.TrimSpace(out.ApiKey)
+	if apiKey == "" {
+		return "", time.Time{}, errors.New("output has no api_key")
+	}
+
+	var expiresAt time.Time
+	if exp := strings.TrimSpace(out.Expiration); exp != "" {
+		t, err := time.Parse(time.RFC3339, exp)
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("expiration must be an RFC3339 timestamp: %w", err)
+		}
+		expiresAt = t
+	}
+	return apiKey, expiresAt, nil
+}
+
+// newProcessCredentials returns credentials that run command whenever a key is needed.
+func newProcessCredentials(command string) *cachedCredentials {
+	return newCachedCredentials(func(ctx context.Context) (string, time.Time, error) {
+		b, err := runCredentialProcessFn(ctx, command)
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("credential_process failed: %w", err)
+		}
+		apiKey, expiresAt, err := parseCredentialProcessOutput(b)
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("credential_process: %w", err)
+		}
+		return apiKey, expiresAt, nil
+	})
+}
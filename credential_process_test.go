@@ -0,0 +1,249 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stubCredentialProcess replaces the credential process with fn for the duration of the test.
+func stubCredentialProcess(t *testing.T, fn func(ctx context.Context, command string) ([]byte, error)) {
+	t.Helper()
+	orig := runCredentialProcessFn
+	runCredentialProcessFn = fn
+	t.Cleanup(func() { runCredentialProcessFn = orig })
+}
+
+func TestParseCredentialProcessOutput(t *testing.T) {
+	key, exp, err := parseCredentialProcessOutput([]byte(`{"api_key":" k1 ","expiration":"2026-01-02T15:04:05Z"}`))
+	if err != nil || key != "k1" || !exp.Equal(time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)) {
+		t.Fatalf("unexpected result: %q %s %v", key, exp, err)
+	}
+
+	key, exp, err = parseCredentialProcessOutput([]byte(`{"api_key":"k2"}`))
+	if err != nil || key != "k2" || !exp.IsZero() {
+		t.Fatalf("expected non-expiring key, got %q %s %v", key, exp, err)
+	}
+
+	tests := map[string]string{
+		`not json`:                                "not a JSON credential document",
+		`{"expiration":"2026-01-02T15:04:05Z"}`:   "no api_key",
+		`{"api_key":"k","expiration":"tomorrow"}`: "RFC3339",
+	}
+	for out, want := range tests {
+		if _, _, err := parseCredentialProcessOutput([]byte(out)); err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("%q: expected error containing %q, got %v", out, want, err)
+		}
+	}
+}
+
+func TestRunCredentialProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell")
+	}
+	out, err := runCredentialProcess(context.Background(), `echo '{"api_key":"k"}'`)
+	if err != nil || strings.TrimSpace(string(out)) != `{"api_key":"k"}` {
+		t.Fatalf("unexpected result: %q %v", out, err)
+	}
+
+	_, err = runCredentialProcess(context.Background(), `echo "vault is sealed" >&2; exit 3`)
+	if err == nil || !strings.Contains(err.Error(), "vault is sealed") {
+		t.Fatalf("expected stderr in error, got %v", err)
+	}
+}
+
+func TestProcessCredentials_RefreshesOnExpiry(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	origNow := nowFn
+	nowFn = func() time.Time { return now }
+	t.Cleanup(func() { nowFn = origNow })
+
+	var runs int
+	stubCredentialProcess(t, func(_ context.Context, command string) ([]byte, error) {
+		runs++
+		if command != "vault-key" {
+			t.Fatalf("unexpected command: %q", command)
+		}
+		return []byte(`{"api_key":"k` + string(rune('0'+runs)) + `","expiration":"2026-01-01T13:00:00Z"}`), nil
+	})
+
+	p := newProcessCredentials("vault-key")
+	for i := 0; i < 2; i++ {
+		if key, err := p.get(context.Background()); err != nil || key != "k1" {
+			t.Fatalf("expected cached key, got %q %v", key, err)
+		}
+	}
+
+	// Inside the expiry window the key is refreshed before it actually expires.
+	now = now.Add(59*time.Minute + 30*time.Second)
+	if key, err := p.get(context.Background()); err != nil || key != "k2" {
+		t.Fatalf("expected refreshed key, got %q %v", key, err)
+	}
+	if runs != 2 {
+		t.Fatalf("expected 2 process runs, got %d", runs)
+	}
+}
+
+func TestProcessCredentials_ShortLivedKeyIsCachedUntilExpiry(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	origNow := nowFn
+	nowFn = func() time.Time { return now }
+	t.Cleanup(func() { nowFn = origNow })
+
+	var runs int
+	stubCredentialProcess(t, func(context.Context, string) ([]byte, error) {
+		runs++
+		return []byte(`{"api_key":"k` + string(rune('0'+runs)) + `","expiration":"` + now.Add(30*time.Second).Format(time.RFC3339) + `"}`), nil
+	})
+
+	p := newProcessCredentials("cmd")
+	for i := 0; i < 3; i++ {
+		if key, err := p.get(context.Background()); err != nil || key != "k1" {
+			t.Fatalf("expected cached key, got %q %v", key, err)
+		}
+		now = now.Add(5 * time.Second)
+	}
+	if runs != 1 {
+		t.Fatalf("expected 1 process run, got %d", runs)
+	}
+
+	now = now.Add(30 * time.Second)
+	if key, err := p.get(context.Background()); err != nil || key != "k2" || runs != 2 {
+		t.Fatalf("expected refresh after expiry, got %q after %d runs (%v)", key, runs, err)
+	}
+}
+
+func TestProcessCredentials_InvalidateOnlyDropsMatchingKey(t *testing.T) {
+	var runs int
+	stubCredentialProcess(t, func(context.Context, string) ([]byte, error) {
+		runs++
+		return []byte(`{"api_key":"k"}`), nil
+	})
+
+	p := newProcessCredentials("cmd")
+	if _, err := p.get(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	p.invalidate("stale")
+	if _, err := p.get(context.Background()); err != nil || runs != 1 {
+		t.Fatalf("expected other key to keep the cache, got %d runs (%v)", runs, err)
+	}
+	p.invalidate("k")
+	if _, err := p.get(context.Background()); err != nil || runs != 2 {
+		t.Fatalf("expected refresh after invalidation, got %d runs (%v)", runs, err)
+	}
+}
+
+func TestAPIClient_doJSONInternal_RefreshesCredentialOn401(t *testing.T) {
+	keys := []string{"old", "new"}
+	stubCredentialProcess(t, func(context.Context, string) ([]byte, error) {
+		key := keys[0]
+		keys = keys[1:]
+		return []byte(`{"api_key":"` + key + `"}`), nil
+	})
+
+	var auths []string
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		auths = append(auths, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") != "Bearer new" {
+			return httpResponse(401, nil, `{"code":"Unauthorized","message":"expired"}`), nil
+		}
+		return httpResponse(200, nil, `{}`), nil
+	}))
+	c.credentials = newProcessCredentials("cmd")
+
+	if diags := c.doJSON(context.Background(), "GET", "https://example.com/x", nil, nil); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", diags)
+	}
+	if strings.Join(auths, ",") != "Bearer old,Bearer new" {
+		t.Fatalf("unexpected Authorization headers: %v", auths)
+	}
+}
+
+func TestAPIClient_doJSONInternal_401AfterRefreshIsError(t *testing.T) {
+	var runs, calls int
+	stubCredentialProcess(t, func(context.Context, string) ([]byte, error) {
+		runs++
+		return []byte(`{"api_key":"k"}`), nil
+	})
+	c := newTestClient(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		calls++
+		return httpResponse(401, nil, `{"code":"Unauthorized","message":"invalid key"}`), nil
+	}))
+	c.credentials = newProcessCredentials("cmd")
+
+	diags := c.doJSON(context.Background(), "GET", "https://example.com/x", nil, nil)
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "invalid key") {
+		t.Fatalf("expected API error, got %#v", diags)
+	}
+	if runs != 2 || calls != 2 {
+		t.Fatalf("expected a single refresh and retry, got %d runs and %d calls", runs, calls)
+	}
+}
+
+func TestAPIClient_doJSONInternal_CredentialProcessError(t *testing.T) {
+	stubCredentialProcess(t, func(context.Context, string) ([]byte, error) {
+		return nil, errors.New("exit status 1")
+	})
+	c := newTestClient(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		t.Fatalf("no request expected without credentials")
+		return nil, nil
+	}))
+	c.credentials = newProcessCredentials("cmd")
+
+	diags := c.doJSON(context.Background(), "GET", "https://example.com/x", nil, nil)
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Credential error" {
+		t.Fatalf("expected credential error, got %#v", diags)
+	}
+}
+
+func TestBuildProviderConfig_CredentialProcess(t *testing.T) {
+	home := isolateCredentials(t)
+	t.Setenv("VIDOS_API_KEY", "")
+
+	cfg, diags := buildProviderConfig(providerModel{CredentialProcess: types.StringValue("vault-key")})
+	if diags.HasError() || cfg.credentialProcess != "vault-key" || cfg.apiKeySecret != "" {
+		t.Fatalf("expected credential process, got %#v %#v", cfg, diags)
+	}
+
+	// The api_key attribute outranks a credential process from the environment.
+	t.Setenv("VIDOS_CREDENTIAL_PROCESS", "env-key-cmd")
+	cfg, diags = buildProviderConfig(providerModel{ApiKey: types.StringValue("secret")})
+	if diags.HasError() || cfg.apiKeySecret != "secret" || cfg.credentialProcess != "" {
+		t.Fatalf("expected api_key attribute to win, got %#v %#v", cfg, diags)
+	}
+
+	_, diags = buildProviderConfig(providerModel{ApiKey: types.StringValue("secret"), CredentialProcess: types.StringValue("vault-key")})
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Conflicting credentials" {
+		t.Fatalf("expected conflict error, got %#v", diags)
+	}
+
+	t.Setenv("VIDOS_CREDENTIAL_PROCESS", "")
+	writeCredentialsFile(t, filepath.Join(home, ".vidos", "credentials"), "[default]\ncredential_process = vault-vidos-key default\n")
+	cfg, diags = buildProviderConfig(providerModel{})
+	if diags.HasError() || cfg.credentialProcess != "vault-vidos-key default" {
+		t.Fatalf("expected credential process from profile, got %#v %#v", cfg, diags)
+	}
+}
+
+func TestNewAPIClient_CredentialProcess(t *testing.T) {
+	if c := NewAPIClient(providerConfig{apiKeySecret: "secret"}); c.credentials != nil {
+		t.Fatalf("expected static key without credential process")
+	}
+	var gotCommand string
+	stubCredentialProcess(t, func(_ context.Context, command string) ([]byte, error) {
+		gotCommand = command
+		return []byte(`{"api_key":"k"}`), nil
+	})
+	c := NewAPIClient(providerConfig{credentialProcess: "vault-key"})
+	if key, err := c.apiKey(context.Background()); err != nil || key != "k" || gotCommand != "vault-key" {
+		t.Fatalf("expected key from credential process, got %q %v (command %q)", key, err, gotCommand)
+	}
+}
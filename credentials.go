@@ -0,0 +1,133 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// userHomeDirFn exists to make the default credentials file location testable.
+// Production code uses os.UserHomeDir.
+var userHomeDirFn = os.UserHomeDir
+
+// defaultProfileName is used when neither the profile attribute nor VIDOS_PROFILE is set.
+const defaultProfileName = "default"
+
+// credentialsProfile is one named section of the shared credentials file:
+//
+//	[sandbox]
+//	api_key      = ...   (or credential_process = vault-vidos-key sandbox)
+//	region       = eu
+//	domain       = staging.example.com
+//	iam_endpoint = http://localhost:8080
+type credentialsProfile struct {
+	apiKey            string
+	credentialProcess string
+	region            string
+	domain            string
+	endpoints         map[string]string
+}
+
+// defaultSharedCredentialsFile returns ~/.vidos/credentials.
+func defaultSharedCredentialsFile() (string, error) {
+	home, err := userHomeDirFn()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".vidos", "credentials"), nil
+}
+
+// loadSharedCredentials reads the credentials file at path. A missing file yields no profiles
+// and found=false so callers can decide whether that is an error.
+func loadSharedCredentials(path string) (profiles map[string]credentialsProfile, found bool, err error) {
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	defer f.Close()
+
+	profiles, err = parseSharedCredentials(f)
+	if err != nil {
+		return nil, true, fmt.Errorf("%s: %w", path, err)
+	}
+	return profiles, true, nil
+}
+
+// parseSharedCredentials parses the INI-style credentials format: [profile] sections holding
+// key = value pairs. Blank lines and lines starting with # or ; are ignored.
+func parseSharedCredentials(r io.Reader) (map[string]credentialsProfile, error) {
+	profiles := map[string]credentialsProfile{}
+	var current string
+	var inSection bool
+
+	scanner := bufio.NewScanner(r)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("line %d: unterminated section header", lineNo)
+			}
+			current = strings.TrimSpace(line[1 : len(line)-1])
+			if current == "" {
+				return nil, fmt.Errorf("line %d: empty profile name", lineNo)
+			}
+			if _, ok := profiles[current]; !ok {
+				profiles[current] = credentialsProfile{endpoints: map[string]string{}}
+			}
+			inSection = true
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", lineNo)
+		}
+		if !inSection {
+			return nil, fmt.Errorf("line %d: %q is outside of a [profile] section", lineNo, strings.TrimSpace(key))
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		p := profiles[current]
+		switch {
+		case key == "api_key":
+			p.apiKey = value
+		case key == "credential_process":
+			p.credentialProcess = value
+		case key == "region":
+			p.region = value
+		case key == "domain":
+			p.domain = value
+		case strings.HasSuffix(key, "_endpoint") && isManagementService(strings.TrimSuffix(key, "_endpoint")):
+			p.endpoints[strings.TrimSuffix(key, "_endpoint")] = value
+		default:
+			return nil, fmt.Errorf("line %d: unknown key %q", lineNo, key)
+		}
+		profiles[current] = p
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+func isManagementService(service string) bool {
+	for _, s := range managementServices {
+		if s == service {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// isolateCredentials points the home directory at an empty temp dir and clears the
+// credentials env vars so a developer's own ~/.vidos/credentials cannot leak into tests.
+// It returns the temp home directory.
+func isolateCredentials(t *testing.T) string {
+	t.Helper()
+	home := t.TempDir()
+	orig := userHomeDirFn
+	userHomeDirFn = func() (string, error) { return home, nil }
+	t.Cleanup(func() { userHomeDirFn = orig })
+	t.Setenv("VIDOS_PROFILE", "")
+	t.Setenv("VIDOS_SHARED_CREDENTIALS_FILE", "")
+	t.Setenv("VIDOS_CREDENTIAL_PROCESS", "")
+	return home
+}
+
+func writeCredentialsFile(t *testing.T, p, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+		t.Fatalf("mkdir: %s", err)
+	}
+	if err := os.WriteFile(p, []byte(content), 0o600); err != nil {
+		t.Fatalf("write credentials: %s", err)
+	}
+}
+
+const testCredentials = `
+# team profiles
+[default]
+api_key = default-key
+
+[sandbox]
+api_key      = sandbox-key
+region       = us
+domain       = staging.example.com
+iam_endpoint = http://localhost:8080
+; gateway_endpoint = https://disabled.example.com
+`
+
+func TestParseSharedCredentials(t *testing.T) {
+	profiles, err := parseSharedCredentials(strings.NewReader(testCredentials))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(profiles) != 2 || profiles["default"].apiKey != "default-key" {
+		t.Fatalf("unexpected profiles: %#v", profiles)
+	}
+	sandbox := profiles["sandbox"]
+	if sandbox.apiKey != "sandbox-key" || sandbox.region != "us" || sandbox.domain != "staging.example.com" {
+		t.Fatalf("unexpected sandbox profile: %#v", sandbox)
+	}
+	if len(sandbox.endpoints) != 1 || sandbox.endpoints["iam"] != "http://localhost:8080" {
+		t.Fatalf("unexpected sandbox endpoints: %#v", sandbox.endpoints)
+	}
+}
+
+func TestParseSharedCredentials_Errors(t *testing.T) {
+	tests := map[string]string{
+		"api_key = x\n":                 "outside of a [profile] section",
+		"[default\n":                    "unterminated section header",
+		"[]\n":                          "empty profile name",
+		"[default]\napi_key\n":          "expected key = value",
+		"[default]\nsecret = x\n":       `unknown key "secret"`,
+		"[default]\nfoo_endpoint = x\n": `unknown key "foo_endpoint"`,
+	}
+	for content, want := range tests {
+		_, err := parseSharedCredentials(strings.NewReader(content))
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("%q: expected error containing %q, got %v", content, want, err)
+		}
+	}
+}
+
+func TestBuildProviderConfig_SharedCredentialsProfile(t *testing.T) {
+	home := isolateCredentials(t)
+	t.Setenv("VIDOS_API_KEY", "")
+	t.Setenv("VIDOS_REGION", "")
+	t.Setenv("VIDOS_DOMAIN", "")
+	t.Setenv("VIDOS_IAM_ENDPOINT", "")
+	writeCredentialsFile(t, filepath.Join(home, ".vidos", "credentials"), testCredentials)
+
+	cfg, diags := buildProviderConfig(providerModel{})
+	if diags.HasError() || cfg.apiKeySecret != "default-key" || cfg.defaultRegion != "eu" {
+		t.Fatalf("expected default profile, got %#v %#v", cfg, diags)
+	}
+
+	t.Setenv("VIDOS_PROFILE", "sandbox")
+	cfg, diags = buildProviderConfig(providerModel{})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", diags)
+	}
+	if cfg.apiKeySecret != "sandbox-key" || cfg.defaultRegion != "us" || cfg.domain != "staging.example.com" || cfg.endpoints["iam"] != "http://localhost:8080" {
+		t.Fatalf("expected sandbox profile, got %#v", cfg)
+	}
+
+	// Attributes and env vars take precedence over a profile picked through VIDOS_PROFILE.
+	t.Setenv("VIDOS_API_KEY", "env-key")
+	t.Setenv("VIDOS_REGION", "ca")
+	cfg, diags = buildProviderConfig(providerModel{})
+	if diags.HasError() || cfg.apiKeySecret != "env-key" || cfg.defaultRegion != "ca" {
+		t.Fatalf("expected env precedence, got %#v %#v", cfg, diags)
+	}
+	cfg, diags = buildProviderConfig(providerModel{Region: types.StringValue("ap")})
+	if diags.HasError() || cfg.defaultRegion != "ap" {
+		t.Fatalf("expected attribute precedence, got %#v %#v", cfg, diags)
+	}
+}
+
+func TestBuildProviderConfig_ExplicitProfileOutranksEnvironment(t *testing.T) {
+	home := isolateCredentials(t)
+	t.Setenv("VIDOS_DOMAIN", "")
+	t.Setenv("VIDOS_IAM_ENDPOINT", "")
+	writeCredentialsFile(t, filepath.Join(home, ".vidos", "credentials"), testCredentials)
+
+	t.Setenv("VIDOS_API_KEY", "dev-key")
+	t.Setenv("VIDOS_REGION", "ca")
+	cfg, diags := buildProviderConfig(providerModel{Profile: types.StringValue("sandbox")})
+	if diags.HasError() || cfg.apiKeySecret != "sandbox-key" || cfg.defaultRegion != "us" {
+		t.Fatalf("expected sandbox profile over the environment, got %#v %#v", cfg, diags)
+	}
+
+	t.Setenv("VIDOS_API_KEY", "")
+	t.Setenv("VIDOS_CREDENTIAL_PROCESS", "echo dev")
+	cfg, diags = buildProviderConfig(providerModel{Profile: types.StringValue("sandbox")})
+	if diags.HasError() || cfg.apiKeySecret != "sandbox-key" || cfg.credentialProcess != "" {
+		t.Fatalf("expected sandbox key over VIDOS_CREDENTIAL_PROCESS, got %#v %#v", cfg, diags)
+	}
+
+	// The profile's hosts are kept too, so its key is never sent to another environment.
+	t.Setenv("VIDOS_DOMAIN", "prod.example.com")
+	t.Setenv("VIDOS_IAM_ENDPOINT", "https://iam.prod.example.com")
+	cfg, diags = buildProviderConfig(providerModel{Profile: types.StringValue("sandbox")})
+	if diags.HasError() || cfg.domain != "staging.example.com" || cfg.endpoints["iam"] != "http://localhost:8080" {
+		t.Fatalf("expected sandbox domain and endpoints over the environment, got %#v %#v", cfg, diags)
+	}
+
+	// Attributes still win over the profile.
+	cfg, diags = buildProviderConfig(providerModel{Profile: types.StringValue("sandbox"), ApiKey: types.StringValue("attr-key"), Region: types.StringValue("ap")})
+	if diags.HasError() || cfg.apiKeySecret != "attr-key" || cfg.defaultRegion != "ap" {
+		t.Fatalf("expected attribute precedence, got %#v %#v", cfg, diags)
+	}
+}
+
+func TestBuildProviderConfig_SharedCredentialsFileFromEnv(t *testing.T) {
+	isolateCredentials(t)
+	t.Setenv("VIDOS_API_KEY", "")
+	p := filepath.Join(t.TempDir(), "creds")
+	writeCredentialsFile(t, p, "[ci]\napi_key = ci-key\n")
+	t.Setenv("VIDOS_SHARED_CREDENTIALS_FILE", p)
+
+	cfg, diags := buildProviderConfig(providerModel{Profile: types.StringValue("ci")})
+	if diags.HasError() || cfg.apiKeySecret != "ci-key" {
+		t.Fatalf("expected ci profile, got %#v %#v", cfg, diags)
+	}
+}
+
+func TestBuildProviderConfig_SharedCredentialsErrors(t *testing.T) {
+	home := isolateCredentials(t)
+	t.Setenv("VIDOS_API_KEY", "secret")
+
+	assertErrorAt := func(diags diag.Diagnostics, want path.Path, summary string) {
+		t.Helper()
+		if diags.ErrorsCount() != 1 {
+			t.Fatalf("expected one error, got %#v", diags)
+		}
+		withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+		if !ok || !withPath.Path().Equal(want) || withPath.Summary() != summary {
+			t.Fatalf("expected %q at %s, got %#v", summary, want, diags.Errors()[0])
+		}
+	}
+
+	// Without a file, only an explicitly selected profile is an error.
+	if _, diags := buildProviderConfig(providerModel{}); diags.HasError() {
+		t.Fatalf("unexpected diagnostics without credentials file: %#v", diags)
+	}
+	_, diags := buildProviderConfig(providerModel{Profile: types.StringValue("prod")})
+	assertErrorAt(diags, path.Root("profile"), "Profile not found")
+
+	_, diags = buildProviderConfig(providerModel{SharedCredentialsFile: types.StringValue(filepath.Join(home, "missing"))})
+	assertErrorAt(diags, path.Root("shared_credentials_file"), "Shared credentials file not found")
+
+	writeCredentialsFile(t, filepath.Join(home, ".vidos", "credentials"), "[default]\napi_key = k\n")
+	_, diags = buildProviderConfig(providerModel{Profile: types.StringValue("prod")})
+	assertErrorAt(diags, path.Root("profile"), "Profile not found")
+
+	writeCredentialsFile(t, filepath.Join(home, "bad"), "[default]\nsecret = k\n")
+	_, diags = buildProviderConfig(providerModel{SharedCredentialsFile: types.StringValue("~/bad")})
+	assertErrorAt(diags, path.Root("shared_credentials_file"), "Invalid shared credentials file")
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+type AuthorizerConfigurationDataSource struct {
+	configurationDataSource
+}
+
+func NewAuthorizerConfigurationDataSource() datasource.DataSource {
+	return &AuthorizerConfigurationDataSource{
+		configurationDataSource: configurationDataSource{
+			baseURL: func(client *APIClient, region string) string {
+				return client.regionalBaseURL("authorizer", region)
+			},
+		},
+	}
+}
+
+var _ datasource.DataSource = (*AuthorizerConfigurationDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*AuthorizerConfigurationDataSource)(nil)
+
+func (d *AuthorizerConfigurationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authorizer_configuration"
+}
+
+func (d *AuthorizerConfigurationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = configurationDataSourceSchema("authorizer")
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+type AuthorizerConfigurationsDataSource struct {
+	configurationsDataSource
+}
+
+func NewAuthorizerConfigurationsDataSource() datasource.DataSource {
+	return &AuthorizerConfigurationsDataSource{
+		configurationsDataSource: configurationsDataSource{
+			baseURL: func(client *APIClient, region string) string {
+				return client.regionalBaseURL("authorizer", region)
+			},
+		},
+	}
+}
+
+var _ datasource.DataSource = (*AuthorizerConfigurationsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*AuthorizerConfigurationsDataSource)(nil)
+
+func (d *AuthorizerConfigurationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authorizer_configurations"
+}
+
+func (d *AuthorizerConfigurationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = configurationsDataSourceSchema("authorizer")
+}
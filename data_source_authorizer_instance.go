@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+type AuthorizerInstanceDataSource struct {
+	instanceDataSource
+}
+
+func NewAuthorizerInstanceDataSource() datasource.DataSource {
+	return &AuthorizerInstanceDataSource{
+		instanceDataSource: instanceDataSource{
+			baseURL: func(client *APIClient, region string) string {
+				return client.regionalBaseURL("authorizer", region)
+			},
+		},
+	}
+}
+
+var _ datasource.DataSource = (*AuthorizerInstanceDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*AuthorizerInstanceDataSource)(nil)
+
+func (d *AuthorizerInstanceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authorizer_instance"
+}
+
+func (d *AuthorizerInstanceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = instanceDataSourceSchema("authorizer")
+}
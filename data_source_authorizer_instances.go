@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+type AuthorizerInstancesDataSource struct {
+	instancesDataSource
+}
+
+func NewAuthorizerInstancesDataSource() datasource.DataSource {
+	return &AuthorizerInstancesDataSource{
+		instancesDataSource: instancesDataSource{
+			baseURL: func(client *APIClient, region string) string {
+				return client.regionalBaseURL("authorizer", region)
+			},
+		},
+	}
+}
+
+var _ datasource.DataSource = (*AuthorizerInstancesDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*AuthorizerInstancesDataSource)(nil)
+
+func (d *AuthorizerInstancesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authorizer_instances"
+}
+
+func (d *AuthorizerInstancesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = instancesDataSourceSchema("authorizer")
+}
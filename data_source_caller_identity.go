@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type callerIdentityDataSourceModel struct {
+	AccountID types.String `tfsdk:"account_id"`
+	ApiKeyID  types.String `tfsdk:"api_key_id"`
+}
+
+type CallerIdentityDataSource struct {
+	client *APIClient
+}
+
+func NewCallerIdentityDataSource() datasource.DataSource {
+	return &CallerIdentityDataSource{}
+}
+
+var _ datasource.DataSource = (*CallerIdentityDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*CallerIdentityDataSource)(nil)
+
+func (d *CallerIdentityDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_caller_identity"
+}
+
+func (d *CallerIdentityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Look up the account and API key the provider is authenticated as.",
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the account that owns the API key.",
+			},
+			"api_key_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Resource ID of the API key the provider uses.",
+			},
+		},
+	}
+}
+
+func (d *CallerIdentityDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*APIClient)
+}
+
+func (d *CallerIdentityDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	identity, diags := d.client.callerIdentity(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := callerIdentityDataSourceModel{
+		AccountID: types.StringValue(identity.AccountID),
+		ApiKeyID:  types.StringValue(identity.ApiKeyID),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// configurationDataSourceModel mirrors configurationModel without the resource-only timeouts block.
+type configurationDataSourceModel struct {
+	Region     types.String `tfsdk:"region"`
+	ResourceID types.String `tfsdk:"resource_id"`
+	Name       types.String `tfsdk:"name"`
+	Values     jsonString   `tfsdk:"values"`
+}
+
+type configurationDataSource struct {
+	client  *APIClient
+	baseURL func(client *APIClient, region string) string
+}
+
+// Note: this is an embedded helper; the wrapper data sources implement Metadata/Schema.
+
+func (d *configurationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*APIClient)
+}
+
+func (d *configurationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config configurationDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceID, ok := requireKnownString(&resp.Diagnostics, config.ResourceID, path.Root("resource_id"), "resource_id")
+	if !ok {
+		return
+	}
+
+	found, out, valuesJSON, diags := readConfigurationIntoState(ctx, d.client, d.baseURL(d.client, config.Region.ValueString()), resourceID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddAttributeError(path.Root("resource_id"), "Configuration not found", fmt.Sprintf("No configuration with resource_id %q exists.", resourceID))
+		return
+	}
+
+	var configuration configurationModel
+	configurationResponseToModel(out, valuesJSON, &configuration)
+	state := configurationDataSourceModel{
+		Region:     config.Region,
+		ResourceID: configuration.ResourceID,
+		Name:       configuration.Name,
+		Values:     configuration.Values,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func configurationDataSourceSchema(service string) schema.Schema {
+	return schema.Schema{
+		Description: "Look up an existing " + service + " configuration by resource_id.",
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "Region the configuration is in (e.g. eu). Defaults to the provider region.",
+				Validators:  []validator.String{regionValidator{}},
+			},
+			"resource_id": schema.StringAttribute{
+				Required:    true,
+				Description: titleCase(service) + " configuration resource ID.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Human readable configuration name.",
+			},
+			"values": schema.StringAttribute{
+				CustomType:  jsonStringType{},
+				Computed:    true,
+				Description: titleCase(service) + " configuration values JSON (string).",
+			},
+		},
+	}
+}
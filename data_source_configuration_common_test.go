@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func readConfigurationDataSource(t *testing.T, d *configurationDataSource, resourceID types.String) datasource.ReadResponse {
+	t.Helper()
+
+	s := configurationDataSourceSchema("authorizer")
+	req := datasource.ReadRequest{Config: dataSourceConfig(t, s, map[string]attr.Value{"resource_id": resourceID})}
+	var resp datasource.ReadResponse
+	initDataSourceState(t, &resp.State, s)
+
+	d.Read(context.Background(), req, &resp)
+	return resp
+}
+
+func TestConfigurationDataSource_Configure(t *testing.T) {
+	d := &configurationDataSource{}
+
+	d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: nil}, &datasource.ConfigureResponse{})
+	if d.client != nil {
+		t.Fatalf("expected nil client")
+	}
+
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return httpResponse(200, nil, `{}`), nil
+	}))
+	d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: c}, &datasource.ConfigureResponse{})
+	if d.client == nil {
+		t.Fatalf("expected client set")
+	}
+}
+
+func TestConfigurationDataSource_Read_PopulatesState(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if got := r.URL.String(); got != "https://authorizer.management.eu.example.com/configurations/rid" {
+			return httpResponse(500, nil, "unexpected url: "+got), nil
+		}
+		return httpResponse(200, nil, `{"configuration":{"resourceId":"rid","name":"n","values":{"a":1}}}`), nil
+	}))
+
+	d := NewAuthorizerConfigurationDataSource().(*AuthorizerConfigurationDataSource)
+	d.client = c
+
+	resp := readConfigurationDataSource(t, &d.configurationDataSource, types.StringValue("rid"))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+
+	var got configurationDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+	if got.ResourceID.ValueString() != "rid" || got.Name.ValueString() != "n" || got.Values.ValueString() != `{"a":1}` {
+		t.Fatalf("unexpected state: %+v", got)
+	}
+}
+
+func TestConfigurationDataSource_Read_UsesRegion(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if got := r.URL.String(); got != "https://authorizer.management.us.example.com/configurations/rid" {
+			return httpResponse(500, nil, "unexpected url: "+got), nil
+		}
+		return httpResponse(200, nil, `{"configuration":{"resourceId":"rid","name":"n"}}`), nil
+	}))
+
+	d := NewAuthorizerConfigurationDataSource().(*AuthorizerConfigurationDataSource)
+	d.client = c
+
+	s := configurationDataSourceSchema("authorizer")
+	req := datasource.ReadRequest{Config: dataSourceConfig(t, s, map[string]attr.Value{"resource_id": types.StringValue("rid"), "region": types.StringValue("us")})}
+	var resp datasource.ReadResponse
+	initDataSourceState(t, &resp.State, s)
+	d.Read(context.Background(), req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+
+	var got configurationDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+	if got.Region.ValueString() != "us" || got.Name.ValueString() != "n" {
+		t.Fatalf("unexpected state: %+v", got)
+	}
+}
+
+func TestConfigurationDataSource_Read_NotFoundAddsDiagnostics(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return httpResponse(404, nil, `{"code":"NotFound","message":"missing"}`), nil
+	}))
+
+	d := &configurationDataSource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
+	resp := readConfigurationDataSource(t, d, types.StringValue("rid"))
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected diagnostics error")
+	}
+}
+
+func TestConfigurationDataSource_Read_EmptyResourceIDAddsDiagnostics(t *testing.T) {
+	var calls int
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		return httpResponse(500, nil, "unexpected"), nil
+	}))
+
+	d := &configurationDataSource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
+	resp := readConfigurationDataSource(t, d, types.StringValue("  "))
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected diagnostics error")
+	}
+	if calls != 0 {
+		t.Fatalf("expected no http calls, got %d", calls)
+	}
+}
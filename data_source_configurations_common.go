@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type configurationsDataSource struct {
+	client  *APIClient
+	baseURL func(client *APIClient, region string) string
+}
+
+type configurationsDataSourceModel struct {
+	Region         types.String                `tfsdk:"region"`
+	NamePrefix     types.String                `tfsdk:"name_prefix"`
+	Configurations []configurationSummaryModel `tfsdk:"configurations"`
+}
+
+type configurationSummaryModel struct {
+	ResourceID types.String `tfsdk:"resource_id"`
+	Name       types.String `tfsdk:"name"`
+}
+
+// Note: this is an embedded helper; the wrapper data sources implement Metadata/Schema.
+
+func (d *configurationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*APIClient)
+}
+
+func (d *configurationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config configurationsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, diags := listConfigurations(ctx, d.client, d.baseURL(d.client, config.Region.ValueString()))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namePrefix := config.NamePrefix.ValueString()
+	state := config
+	state.Configurations = make([]configurationSummaryModel, 0, len(items))
+	for _, item := range items {
+		if namePrefix != "" && !strings.HasPrefix(item.Name, namePrefix) {
+			continue
+		}
+		state.Configurations = append(state.Configurations, configurationSummaryModel{
+			ResourceID: types.StringValue(item.ResourceID),
+			Name:       types.StringValue(item.Name),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func configurationsDataSourceSchema(service string) schema.Schema {
+	return schema.Schema{
+		Description: "List " + service + " configurations, optionally filtered by name prefix.",
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "Region to list configurations in (e.g. eu). Defaults to the provider region.",
+				Validators:  []validator.String{regionValidator{}},
+			},
+			"name_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return configurations whose name starts with this prefix.",
+			},
+			"configurations": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Matching " + service + " configurations.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_id": schema.StringAttribute{
+							Computed:    true,
+							Description: titleCase(service) + " configuration resource ID.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Human readable configuration name.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func readConfigurationsDataSource(t *testing.T, d *configurationsDataSource, filters map[string]attr.Value) (configurationsDataSourceModel, datasource.ReadResponse) {
+	t.Helper()
+
+	s := configurationsDataSourceSchema("gateway")
+	req := datasource.ReadRequest{Config: dataSourceConfig(t, s, filters)}
+	var resp datasource.ReadResponse
+	initDataSourceState(t, &resp.State, s)
+
+	d.Read(context.Background(), req, &resp)
+
+	var got configurationsDataSourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+	}
+	return got, resp
+}
+
+func TestConfigurationsDataSource_Configure(t *testing.T) {
+	d := &configurationsDataSource{}
+
+	d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: nil}, &datasource.ConfigureResponse{})
+	if d.client != nil {
+		t.Fatalf("expected nil client")
+	}
+
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return httpResponse(200, nil, `{}`), nil
+	}))
+	d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: c}, &datasource.ConfigureResponse{})
+	if d.client == nil {
+		t.Fatalf("expected client set")
+	}
+}
+
+func TestConfigurationsDataSource_Read_FiltersByNamePrefix(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if got := r.URL.String(); got != "https://gateway.management.eu.example.com/configurations" {
+			return httpResponse(500, nil, "unexpected url: "+got), nil
+		}
+		return httpResponse(200, nil, `{"configurations":[{"resourceId":"c1","name":"shared-routes"},{"resourceId":"c2","name":"team-routes"}]}`), nil
+	}))
+
+	d := NewGatewayConfigurationsDataSource().(*GatewayConfigurationsDataSource)
+	d.client = c
+
+	got, resp := readConfigurationsDataSource(t, &d.configurationsDataSource, nil)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+	if len(got.Configurations) != 2 {
+		t.Fatalf("expected 2 configurations, got %d", len(got.Configurations))
+	}
+
+	got, resp = readConfigurationsDataSource(t, &d.configurationsDataSource, map[string]attr.Value{"name_prefix": types.StringValue("shared-")})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+	if len(got.Configurations) != 1 || got.Configurations[0].ResourceID.ValueString() != "c1" {
+		t.Fatalf("unexpected filtered configurations: %+v", got.Configurations)
+	}
+}
+
+func TestConfigurationsDataSource_Read_UsesRegion(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if got := r.URL.String(); got != "https://gateway.management.us.example.com/configurations" {
+			return httpResponse(500, nil, "unexpected url: "+got), nil
+		}
+		return httpResponse(200, nil, `{"configurations":[{"resourceId":"c1","name":"shared-routes"}]}`), nil
+	}))
+
+	d := NewGatewayConfigurationsDataSource().(*GatewayConfigurationsDataSource)
+	d.client = c
+
+	got, resp := readConfigurationsDataSource(t, &d.configurationsDataSource, map[string]attr.Value{"region": types.StringValue("us")})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+	if len(got.Configurations) != 1 || got.Region.ValueString() != "us" {
+		t.Fatalf("unexpected state: %+v", got)
+	}
+}
+
+func TestConfigurationsDataSource_Read_APIErrorAddsDiagnostics(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return httpResponse(400, nil, `{"code":"Bad","message":"nope"}`), nil
+	}))
+	d := &configurationsDataSource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
+
+	_, resp := readConfigurationsDataSource(t, d, nil)
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected diagnostics error")
+	}
+}
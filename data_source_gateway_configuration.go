@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+type GatewayConfigurationDataSource struct {
+	configurationDataSource
+}
+
+func NewGatewayConfigurationDataSource() datasource.DataSource {
+	return &GatewayConfigurationDataSource{
+		configurationDataSource: configurationDataSource{
+			baseURL: func(client *APIClient, region string) string {
+				return client.regionalBaseURL("gateway", region)
+			},
+		},
+	}
+}
+
+var _ datasource.DataSource = (*GatewayConfigurationDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*GatewayConfigurationDataSource)(nil)
+
+func (d *GatewayConfigurationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gateway_configuration"
+}
+
+func (d *GatewayConfigurationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = configurationDataSourceSchema("gateway")
+}
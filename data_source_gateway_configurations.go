@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+type GatewayConfigurationsDataSource struct {
+	configurationsDataSource
+}
+
+func NewGatewayConfigurationsDataSource() datasource.DataSource {
+	return &GatewayConfigurationsDataSource{
+		configurationsDataSource: configurationsDataSource{
+			baseURL: func(client *APIClient, region string) string {
+				return client.regionalBaseURL("gateway", region)
+			},
+		},
+	}
+}
+
+var _ datasource.DataSource = (*GatewayConfigurationsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*GatewayConfigurationsDataSource)(nil)
+
+func (d *GatewayConfigurationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gateway_configurations"
+}
+
+func (d *GatewayConfigurationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = configurationsDataSourceSchema("gateway")
+}
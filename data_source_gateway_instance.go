@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+type GatewayInstanceDataSource struct {
+	instanceDataSource
+}
+
+func NewGatewayInstanceDataSource() datasource.DataSource {
+	return &GatewayInstanceDataSource{
+		instanceDataSource: instanceDataSource{
+			baseURL: func(client *APIClient, region string) string {
+				return client.regionalBaseURL("gateway", region)
+			},
+		},
+	}
+}
+
+var _ datasource.DataSource = (*GatewayInstanceDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*GatewayInstanceDataSource)(nil)
+
+func (d *GatewayInstanceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gateway_instance"
+}
+
+func (d *GatewayInstanceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = instanceDataSourceSchema("gateway")
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+type GatewayInstancesDataSource struct {
+	instancesDataSource
+}
+
+func NewGatewayInstancesDataSource() datasource.DataSource {
+	return &GatewayInstancesDataSource{
+		instancesDataSource: instancesDataSource{
+			baseURL: func(client *APIClient, region string) string {
+				return client.regionalBaseURL("gateway", region)
+			},
+		},
+	}
+}
+
+var _ datasource.DataSource = (*GatewayInstancesDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*GatewayInstancesDataSource)(nil)
+
+func (d *GatewayInstancesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gateway_instances"
+}
+
+func (d *GatewayInstancesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = instancesDataSourceSchema("gateway")
+}
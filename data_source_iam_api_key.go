@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type IamApiKeyDataSource struct {
+	client *APIClient
+}
+
+// iamApiKeyDataSourceModel mirrors iamApiKeyModel without api_secret, which is only
+// ever returned on create.
+type iamApiKeyDataSourceModel struct {
+	ResourceID           types.String `tfsdk:"resource_id"`
+	Name                 types.String `tfsdk:"name"`
+	InlinePolicyDocument jsonString   `tfsdk:"inline_policy_document"`
+}
+
+func NewIamApiKeyDataSource() datasource.DataSource {
+	return &IamApiKeyDataSource{}
+}
+
+var _ datasource.DataSource = (*IamApiKeyDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*IamApiKeyDataSource)(nil)
+
+func (d *IamApiKeyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_api_key"
+}
+
+func (d *IamApiKeyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Look up an existing IAM API key by resource_id. The API secret is never exposed.",
+		Attributes: map[string]schema.Attribute{
+			"resource_id": schema.StringAttribute{
+				Required:    true,
+				Description: "API key resource ID.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Human readable API key name.",
+			},
+			"inline_policy_document": schema.StringAttribute{
+				CustomType:  jsonStringType{},
+				Computed:    true,
+				Description: "Inline policy document JSON (string) for this API key.",
+			},
+		},
+	}
+}
+
+func (d *IamApiKeyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*APIClient)
+}
+
+func (d *IamApiKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config iamApiKeyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceID, ok := requireKnownString(&resp.Diagnostics, config.ResourceID, path.Root("resource_id"), "resource_id")
+	if !ok {
+		return
+	}
+
+	var apiKey iamApiKeyModel
+	found, diags := (&IamApiKeyResource{client: d.client}).readIntoState(ctx, resourceID, &apiKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddAttributeError(path.Root("resource_id"), "API key not found", fmt.Sprintf("No API key with resource_id %q exists.", resourceID))
+		return
+	}
+
+	state := iamApiKeyDataSourceModel{
+		ResourceID:           apiKey.ResourceID,
+		Name:                 apiKey.Name,
+		InlinePolicyDocument: apiKey.InlinePolicyDocument,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type iamManagedPoliciesDataSourceModel struct {
+	NamePrefix types.String                `tfsdk:"name_prefix"`
+	Policies   []managedPolicySummaryModel `tfsdk:"policies"`
+}
+
+type managedPolicySummaryModel struct {
+	ResourceID types.String `tfsdk:"resource_id"`
+	Name       types.String `tfsdk:"name"`
+}
+
+type managedPolicyListItem struct {
+	ResourceID string `json:"resourceId"`
+	Name       string `json:"name"`
+}
+
+type IamManagedPoliciesDataSource struct {
+	client *APIClient
+}
+
+func NewIamManagedPoliciesDataSource() datasource.DataSource {
+	return &IamManagedPoliciesDataSource{}
+}
+
+var _ datasource.DataSource = (*IamManagedPoliciesDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*IamManagedPoliciesDataSource)(nil)
+
+func (d *IamManagedPoliciesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_managed_policies"
+}
+
+func (d *IamManagedPoliciesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List the Vidos managed IAM policies, optionally filtered by name prefix.",
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return policies whose name starts with this prefix.",
+			},
+			"policies": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Matching managed policies.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Managed policy resource ID, usable as policy_id with policy_type = managed.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Human readable policy name.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IamManagedPoliciesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*APIClient)
+}
+
+func (d *IamManagedPoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config iamManagedPoliciesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, diags := listManagedPolicies(ctx, d.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namePrefix := config.NamePrefix.ValueString()
+	state := config
+	state.Policies = make([]managedPolicySummaryModel, 0, len(items))
+	for _, item := range items {
+		if namePrefix != "" && !strings.HasPrefix(item.Name, namePrefix) {
+			continue
+		}
+		state.Policies = append(state.Policies, managedPolicySummaryModel{
+			ResourceID: types.StringValue(item.ResourceID),
+			Name:       types.StringValue(item.Name),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func listManagedPolicies(ctx context.Context, client *APIClient) ([]managedPolicyListItem, diag.Diagnostics) {
+	// listAllPages keeps the query when it adds nextToken.
+	return listAllPages[managedPolicyListItem](ctx, client, client.iamBaseURL(), "/policies?policyType=managed", "policies")
+}
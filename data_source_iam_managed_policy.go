@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type iamManagedPolicyDataSourceModel struct {
+	ResourceID types.String `tfsdk:"resource_id"`
+	Name       types.String `tfsdk:"name"`
+	Document   jsonString   `tfsdk:"document"`
+}
+
+type IamManagedPolicyDataSource struct {
+	client *APIClient
+}
+
+func NewIamManagedPolicyDataSource() datasource.DataSource {
+	return &IamManagedPolicyDataSource{}
+}
+
+var _ datasource.DataSource = (*IamManagedPolicyDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*IamManagedPolicyDataSource)(nil)
+
+func (d *IamManagedPolicyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_managed_policy"
+}
+
+func (d *IamManagedPolicyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Look up a Vidos managed IAM policy by resource_id.",
+		Attributes: map[string]schema.Attribute{
+			"resource_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Managed policy resource ID, e.g. validator_all_actions.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Human readable policy name.",
+			},
+			"document": schema.StringAttribute{
+				CustomType:  jsonStringType{},
+				Computed:    true,
+				Description: "Policy document JSON (string).",
+			},
+		},
+	}
+}
+
+func (d *IamManagedPolicyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*APIClient)
+}
+
+func (d *IamManagedPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config iamManagedPolicyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceID, ok := requireKnownString(&resp.Diagnostics, config.ResourceID, path.Root("resource_id"), "resource_id")
+	if !ok {
+		return
+	}
+
+	state, found, diags := getManagedPolicy(ctx, d.client, resourceID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddAttributeError(path.Root("resource_id"), "Managed policy not found",
+			fmt.Sprintf("No managed policy with resource_id %q exists. The vidos_iam_managed_policies data source lists the available ones.", resourceID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// getManagedPolicy reads a managed policy through the same endpoint the attachment resources
+// use to check that a policy exists.
+func getManagedPolicy(ctx context.Context, client *APIClient, resourceID string) (iamManagedPolicyDataSourceModel, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	type policyResponse struct {
+		Policy struct {
+			ResourceID string `json:"resourceId"`
+			Name       string `json:"name"`
+			Document   any    `json:"document"`
+		} `json:"policy"`
+	}
+
+	var out policyResponse
+	getURL := joinURLWithQuery(client.iamBaseURL(), fmt.Sprintf("/policies/%s", url.PathEscape(resourceID)), map[string]string{"policyType": "managed"})
+	found, getDiags := client.doJSONAllowNotFound(ctx, "GET", getURL, nil, &out)
+	diags.Append(getDiags...)
+	if diags.HasError() || !found {
+		return iamManagedPolicyDataSourceModel{}, false, diags
+	}
+
+	doc, err := jsonMarshal(out.Policy.Document)
+	if err != nil {
+		diags.AddError("Document encode error", err.Error())
+		return iamManagedPolicyDataSourceModel{}, true, diags
+	}
+
+	return iamManagedPolicyDataSourceModel{
+		ResourceID: types.StringValue(firstNonEmpty(out.Policy.ResourceID, resourceID)),
+		Name:       types.StringValue(out.Policy.Name),
+		Document:   jsonStringValue(string(doc)),
+	}, true, diags
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIamManagedPolicyDataSource_Read_PopulatesState(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if got := r.URL.String(); got != "https://iam.management.global.example.com/policies/validator_all_actions?policyType=managed" {
+			return httpResponse(500, nil, "unexpected url: "+got), nil
+		}
+		return httpResponse(200, nil, `{"policy":{"resourceId":"validator_all_actions","name":"Validator all actions","document":{"version":"1.0"},"policyType":"managed"}}`), nil
+	}))
+
+	resp := readIamDataSource(t, &IamManagedPolicyDataSource{client: c}, "validator_all_actions")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+
+	var got iamManagedPolicyDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+	if got.Name.ValueString() != "Validator all actions" || got.Document.ValueString() != `{"version":"1.0"}` {
+		t.Fatalf("unexpected state: %+v", got)
+	}
+}
+
+func TestIamManagedPolicyDataSource_Read_NotFoundAddsDiagnostics(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return httpResponse(404, nil, `{"code":"NotFound","message":"missing"}`), nil
+	}))
+
+	resp := readIamDataSource(t, &IamManagedPolicyDataSource{client: c}, "authoriser_all_actions")
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Managed policy not found" {
+		t.Fatalf("expected not found error, got %#v", resp.Diagnostics)
+	}
+}
+
+func TestIamManagedPoliciesDataSource_Read_FollowsPagesAndFiltersByNamePrefix(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		switch r.URL.String() {
+		case "https://iam.management.global.example.com/policies?policyType=managed":
+			return httpResponse(200, nil, `{"policies":[{"resourceId":"authorizer_all_actions","name":"authorizer all actions"}],"nextToken":"p2"}`), nil
+		case "https://iam.management.global.example.com/policies?nextToken=p2&policyType=managed":
+			return httpResponse(200, nil, `{"policies":[{"resourceId":"validator_all_actions","name":"validator all actions"}]}`), nil
+		default:
+			return httpResponse(500, nil, "unexpected url: "+r.URL.String()), nil
+		}
+	}))
+	d := NewIamManagedPoliciesDataSource()
+	d.(*IamManagedPoliciesDataSource).client = c
+
+	read := func(filters map[string]attr.Value) iamManagedPoliciesDataSourceModel {
+		t.Helper()
+		ctx := context.Background()
+		var sch datasource.SchemaResponse
+		d.Schema(ctx, datasource.SchemaRequest{}, &sch)
+		var resp datasource.ReadResponse
+		initDataSourceState(t, &resp.State, sch.Schema)
+		d.Read(ctx, datasource.ReadRequest{Config: dataSourceConfig(t, sch.Schema, filters)}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+		}
+		var got iamManagedPoliciesDataSourceModel
+		resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+		return got
+	}
+
+	if got := read(nil); len(got.Policies) != 2 {
+		t.Fatalf("expected 2 policies, got %+v", got.Policies)
+	}
+	got := read(map[string]attr.Value{"name_prefix": types.StringValue("validator")})
+	if len(got.Policies) != 1 || got.Policies[0].ResourceID.ValueString() != "validator_all_actions" {
+		t.Fatalf("unexpected filtered policies: %+v", got.Policies)
+	}
+}
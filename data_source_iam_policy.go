@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// iamPolicyDataSourceModel mirrors iamPolicyModel without the resource-only timeouts block.
+type iamPolicyDataSourceModel struct {
+	ResourceID types.String `tfsdk:"resource_id"`
+	Name       types.String `tfsdk:"name"`
+	Document   jsonString   `tfsdk:"document"`
+}
+
+type IamPolicyDataSource struct {
+	client *APIClient
+}
+
+func NewIamPolicyDataSource() datasource.DataSource {
+	return &IamPolicyDataSource{}
+}
+
+var _ datasource.DataSource = (*IamPolicyDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*IamPolicyDataSource)(nil)
+
+func (d *IamPolicyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_policy"
+}
+
+func (d *IamPolicyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Look up an existing IAM account policy by resource_id.",
+		Attributes: map[string]schema.Attribute{
+			"resource_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Account policy resource ID.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Human readable policy name.",
+			},
+			"document": schema.StringAttribute{
+				CustomType:  jsonStringType{},
+				Computed:    true,
+				Description: "Policy document JSON (string).",
+			},
+		},
+	}
+}
+
+func (d *IamPolicyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*APIClient)
+}
+
+func (d *IamPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config iamPolicyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceID, ok := requireKnownString(&resp.Diagnostics, config.ResourceID, path.Root("resource_id"), "resource_id")
+	if !ok {
+		return
+	}
+
+	var policy iamPolicyModel
+	found, diags := (&IamPolicyResource{client: d.client}).readIntoState(ctx, resourceID, &policy)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddAttributeError(path.Root("resource_id"), "Policy not found", fmt.Sprintf("No account policy with resource_id %q exists.", resourceID))
+		return
+	}
+
+	state := iamPolicyDataSourceModel{
+		ResourceID: policy.ResourceID,
+		Name:       policy.Name,
+		Document:   policy.Document,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,340 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultPolicyDocumentVersion is the policy language version rendered when neither the data
+// source nor a source document sets one.
+const defaultPolicyDocumentVersion = "1.0"
+
+type iamPolicyDocumentDataSourceModel struct {
+	Version                 types.String              `tfsdk:"version"`
+	SourcePolicyDocuments   []types.String            `tfsdk:"source_policy_documents"`
+	OverridePolicyDocuments []types.String            `tfsdk:"override_policy_documents"`
+	Statements              []iamPolicyStatementModel `tfsdk:"statement"`
+	JSON                    jsonString                `tfsdk:"json"`
+}
+
+type iamPolicyStatementModel struct {
+	Sid        types.String                      `tfsdk:"sid"`
+	Effect     types.String                      `tfsdk:"effect"`
+	Scope      types.String                      `tfsdk:"scope"`
+	Actions    []types.String                    `tfsdk:"actions"`
+	Resources  []iamPolicyStatementResourceModel `tfsdk:"resource"`
+	Conditions []iamPolicyConditionModel         `tfsdk:"condition"`
+}
+
+type iamPolicyStatementResourceModel struct {
+	Region       types.String `tfsdk:"region"`
+	Service      types.String `tfsdk:"service"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	ResourceID   types.String `tfsdk:"resource_id"`
+}
+
+type iamPolicyConditionModel struct {
+	Operator types.String   `tfsdk:"operator"`
+	Key      types.String   `tfsdk:"key"`
+	Values   []types.String `tfsdk:"values"`
+}
+
+type IamPolicyDocumentDataSource struct{}
+
+func NewIamPolicyDocumentDataSource() datasource.DataSource {
+	return &IamPolicyDocumentDataSource{}
+}
+
+var _ datasource.DataSource = (*IamPolicyDocumentDataSource)(nil)
+
+func (d *IamPolicyDocumentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_policy_document"
+}
+
+func (d *IamPolicyDocumentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Render an IAM policy document as canonical JSON for vidos_iam_policy.document or an inline_policy_document. Nothing is sent to the API.",
+		Attributes: map[string]schema.Attribute{
+			"version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Policy language version. Defaults to the version of the first source document, then " + defaultPolicyDocumentVersion + ".",
+			},
+			"source_policy_documents": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Policy documents whose permissions come first. A statement with the same sid replaces the source permission.",
+			},
+			"override_policy_documents": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Policy documents applied last, in order. A permission with the same sid replaces the earlier one; others are appended.",
+			},
+			"json": schema.StringAttribute{
+				CustomType:  jsonStringType{},
+				Computed:    true,
+				Description: "The rendered policy document.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"statement": schema.ListNestedBlock{
+				Description: "A permission of the document.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"sid": schema.StringAttribute{
+							Optional:    true,
+							Description: "Statement ID, used to replace statements when merging documents. Not included in json.",
+						},
+						"effect": schema.StringAttribute{
+							Optional:    true,
+							Description: "allow (default) or deny.",
+							Validators:  []validator.String{stringOneOfValidator{values: []string{"allow", "deny"}}},
+						},
+						"scope": schema.StringAttribute{
+							Optional:    true,
+							Description: "Scope the permission applies to. Defaults to management.",
+						},
+						"actions": schema.ListAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Description: "Actions the permission allows or denies, e.g. read or list.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"resource": schema.ListNestedBlock{
+							Description: "Resources the permission applies to.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"region": schema.StringAttribute{
+										Optional:    true,
+										Description: "Region, e.g. global or eu. Defaults to *.",
+									},
+									"service": schema.StringAttribute{
+										Required:    true,
+										Description: "Service, e.g. iam or gateway.",
+									},
+									"resource_type": schema.StringAttribute{
+										Optional:    true,
+										Description: "Resource type within the service. Defaults to *.",
+									},
+									"resource_id": schema.StringAttribute{
+										Optional:    true,
+										Description: "Resource ID. Defaults to *.",
+									},
+								},
+							},
+						},
+						"condition": schema.ListNestedBlock{
+							Description: "Conditions that must all hold for the permission to apply.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"operator": schema.StringAttribute{
+										Required:    true,
+										Description: "Condition operator.",
+									},
+									"key": schema.StringAttribute{
+										Required:    true,
+										Description: "Context key the condition tests.",
+									},
+									"values": schema.ListAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+										Description: "Values compared with the key.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IamPolicyDocumentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config iamPolicyDocumentDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rendered, diags := renderPolicyDocument(config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.JSON = jsonStringValue(rendered)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// renderPolicyDocument merges the source documents, the statements and the override documents,
+// in that order, and encodes the result with sorted keys.
+func renderPolicyDocument(config iamPolicyDocumentDataSourceModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var merged policyPermissions
+	version := config.Version.ValueString()
+
+	for i, raw := range config.SourcePolicyDocuments {
+		p := path.Root("source_policy_documents").AtListIndex(i)
+		doc, ok := parsePolicyDocument(&diags, raw.ValueString(), p)
+		if !ok {
+			continue
+		}
+		if version == "" {
+			version = doc.version
+		}
+		for _, permission := range doc.permissions {
+			if sid := permissionSid(permission); sid != "" && merged.index(sid) >= 0 {
+				diags.AddAttributeError(p, "Duplicate sid", fmt.Sprintf("Statement ID %q appears in more than one source policy document.", sid))
+				continue
+			}
+			merged = append(merged, permission)
+		}
+	}
+
+	for _, statement := range config.Statements {
+		merged.put(renderPolicyStatement(statement))
+	}
+
+	for i, raw := range config.OverridePolicyDocuments {
+		doc, ok := parsePolicyDocument(&diags, raw.ValueString(), path.Root("override_policy_documents").AtListIndex(i))
+		if !ok {
+			continue
+		}
+		for _, permission := range doc.permissions {
+			merged.put(permission)
+		}
+	}
+	if diags.HasError() {
+		return "", diags
+	}
+
+	out, err := json.Marshal(map[string]any{
+		"version":     firstNonEmpty(version, defaultPolicyDocumentVersion),
+		"permissions": merged.withoutSids(),
+	})
+	if err != nil {
+		diags.AddError("JSON encode error", err.Error())
+		return "", diags
+	}
+	return string(out), diags
+}
+
+// policyPermissions keeps permissions as decoded JSON so fields the data source doesn't model
+// survive merging.
+type policyPermissions []map[string]any
+
+func (ps policyPermissions) index(sid string) int {
+	for i, p := range ps {
+		if permissionSid(p) == sid {
+			return i
+		}
+	}
+	return -1
+}
+
+// put replaces the permission with the same sid, or appends when there is none.
+func (ps *policyPermissions) put(permission map[string]any) {
+	if sid := permissionSid(permission); sid != "" {
+		if i := ps.index(sid); i >= 0 {
+			(*ps)[i] = permission
+			return
+		}
+	}
+	*ps = append(*ps, permission)
+}
+
+// withoutSids returns the permissions as they are sent to the API. sid only serves as the merge
+// key here; it is not part of the policy language.
+func (ps policyPermissions) withoutSids() []map[string]any {
+	out := make([]map[string]any, 0, len(ps))
+	for _, p := range ps {
+		if _, ok := p["sid"]; !ok {
+			out = append(out, p)
+			continue
+		}
+		stripped := make(map[string]any, len(p))
+		for k, v := range p {
+			if k != "sid" {
+				stripped[k] = v
+			}
+		}
+		out = append(out, stripped)
+	}
+	return out
+}
+
+func permissionSid(permission map[string]any) string {
+	sid, _ := permission["sid"].(string)
+	return sid
+}
+
+type parsedPolicyDocument struct {
+	version     string
+	permissions policyPermissions
+}
+
+func parsePolicyDocument(diags *diag.Diagnostics, raw string, p path.Path) (parsedPolicyDocument, bool) {
+	var doc struct {
+		Version     string           `json:"version"`
+		Permissions []map[string]any `json:"permissions"`
+	}
+	if err := json.Unmarshal([]byte(raw), &doc); err != nil {
+		diags.AddAttributeError(p, "Invalid policy document", fmt.Sprintf("Expected a JSON object with a permissions list: %s", err))
+		return parsedPolicyDocument{}, false
+	}
+	return parsedPolicyDocument{version: doc.Version, permissions: doc.Permissions}, true
+}
+
+func renderPolicyStatement(s iamPolicyStatementModel) map[string]any {
+	permission := map[string]any{
+		"effect":    firstNonEmpty(s.Effect.ValueString(), "allow"),
+		"scope":     firstNonEmpty(s.Scope.ValueString(), "management"),
+		"actions":   stringValues(s.Actions),
+		"resources": []map[string]any{},
+	}
+	if sid := strings.TrimSpace(s.Sid.ValueString()); sid != "" {
+		permission["sid"] = sid
+	}
+
+	resources := make([]map[string]any, 0, len(s.Resources))
+	for _, r := range s.Resources {
+		resources = append(resources, map[string]any{
+			"region":       firstNonEmpty(r.Region.ValueString(), "*"),
+			"service":      r.Service.ValueString(),
+			"resourceType": firstNonEmpty(r.ResourceType.ValueString(), "*"),
+			"resourceId":   firstNonEmpty(r.ResourceID.ValueString(), "*"),
+		})
+	}
+	permission["resources"] = resources
+
+	if len(s.Conditions) > 0 {
+		conditions := make([]map[string]any, 0, len(s.Conditions))
+		for _, c := range s.Conditions {
+			conditions = append(conditions, map[string]any{
+				"operator": c.Operator.ValueString(),
+				"key":      c.Key.ValueString(),
+				"values":   stringValues(c.Values),
+			})
+		}
+		permission["conditions"] = conditions
+	}
+	return permission
+}
+
+func stringValues(in []types.String) []string {
+	out := make([]string, 0, len(in))
+	for _, v := range in {
+		out = append(out, v.ValueString())
+	}
+	return out
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func stringList(values ...string) []types.String {
+	out := make([]types.String, 0, len(values))
+	for _, v := range values {
+		out = append(out, types.StringValue(v))
+	}
+	return out
+}
+
+func TestRenderPolicyDocument_StatementDefaults(t *testing.T) {
+	got, diags := renderPolicyDocument(iamPolicyDocumentDataSourceModel{
+		Statements: []iamPolicyStatementModel{
+			{
+				Actions:   stringList("read", "list"),
+				Resources: []iamPolicyStatementResourceModel{{Region: types.StringValue("global"), Service: types.StringValue("iam")}},
+			},
+			{
+				Sid:     types.StringValue("no-deletes"),
+				Effect:  types.StringValue("deny"),
+				Actions: stringList("delete"),
+				Resources: []iamPolicyStatementResourceModel{
+					{Service: types.StringValue("gateway"), ResourceType: types.StringValue("instance"), ResourceID: types.StringValue("prod")},
+				},
+				Conditions: []iamPolicyConditionModel{
+					{Operator: types.StringValue("StringEquals"), Key: types.StringValue("region"), Values: stringList("eu")},
+				},
+			},
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", diags)
+	}
+	want := `{"permissions":[` +
+		`{"actions":["read","list"],"effect":"allow","resources":[{"region":"global","resourceId":"*","resourceType":"*","service":"iam"}],"scope":"management"},` +
+		`{"actions":["delete"],"conditions":[{"key":"region","operator":"StringEquals","values":["eu"]}],"effect":"deny","resources":[{"region":"*","resourceId":"prod","resourceType":"instance","service":"gateway"}],"scope":"management"}` +
+		`],"version":"1.0"}`
+	if got != want {
+		t.Fatalf("unexpected document:\n got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestRenderPolicyDocument_MergesSourceAndOverrideDocuments(t *testing.T) {
+	got, diags := renderPolicyDocument(iamPolicyDocumentDataSourceModel{
+		SourcePolicyDocuments: stringList(
+			`{"version":"1.1","permissions":[{"sid":"read","effect":"allow","actions":["read"],"custom":true},{"effect":"allow","actions":["list"]}]}`,
+		),
+		Statements: []iamPolicyStatementModel{
+			{Sid: types.StringValue("read"), Actions: stringList("read", "list")},
+		},
+		OverridePolicyDocuments: stringList(
+			`{"permissions":[{"sid":"read","effect":"deny","actions":["read"]},{"sid":"extra","effect":"allow","actions":["update"]}]}`,
+		),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", diags)
+	}
+	// The statement replaced the source permission, then the override replaced the statement in
+	// place; unknown fields of untouched permissions are kept and sids are dropped.
+	want := `{"permissions":[` +
+		`{"actions":["read"],"effect":"deny"},` +
+		`{"actions":["list"],"effect":"allow"},` +
+		`{"actions":["update"],"effect":"allow"}` +
+		`],"version":"1.1"}`
+	if got != want {
+		t.Fatalf("unexpected document:\n got: %s\nwant: %s", got, want)
+	}
+
+	got, diags = renderPolicyDocument(iamPolicyDocumentDataSourceModel{
+		Version:               types.StringValue("2.0"),
+		SourcePolicyDocuments: stringList(`{"version":"1.1","permissions":[]}`),
+	})
+	if diags.HasError() || got != `{"permissions":[],"version":"2.0"}` {
+		t.Fatalf("expected version attribute to win, got %s %#v", got, diags)
+	}
+}
+
+func TestRenderPolicyDocument_OmitsSids(t *testing.T) {
+	got, diags := renderPolicyDocument(iamPolicyDocumentDataSourceModel{
+		SourcePolicyDocuments: stringList(`{"permissions":[{"sid":"source","effect":"allow","actions":["list"]}]}`),
+		Statements:            []iamPolicyStatementModel{{Sid: types.StringValue("statement"), Actions: stringList("read")}},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", diags)
+	}
+	if strings.Contains(got, "sid") {
+		t.Fatalf("expected no sid in rendered document, got %s", got)
+	}
+}
+
+func TestRenderPolicyDocument_Errors(t *testing.T) {
+	assertErrorAt := func(diags diag.Diagnostics, want path.Path) {
+		t.Helper()
+		if !diags.HasError() {
+			t.Fatalf("expected error at %s", want)
+		}
+		withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+		if !ok || !withPath.Path().Equal(want) {
+			t.Fatalf("expected error at %s, got %#v", want, diags.Errors()[0])
+		}
+	}
+
+	_, diags := renderPolicyDocument(iamPolicyDocumentDataSourceModel{
+		SourcePolicyDocuments: stringList(`{"permissions":[]}`, `{"permissions":`),
+	})
+	assertErrorAt(diags, path.Root("source_policy_documents").AtListIndex(1))
+
+	_, diags = renderPolicyDocument(iamPolicyDocumentDataSourceModel{
+		SourcePolicyDocuments: stringList(`{"permissions":[{"sid":"a"}]}`, `{"permissions":[{"sid":"a"}]}`),
+	})
+	assertErrorAt(diags, path.Root("source_policy_documents").AtListIndex(1))
+
+	_, diags = renderPolicyDocument(iamPolicyDocumentDataSourceModel{
+		OverridePolicyDocuments: stringList(`[]`),
+	})
+	assertErrorAt(diags, path.Root("override_policy_documents").AtListIndex(0))
+}
+
+func TestIamPolicyDocumentDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	d := NewIamPolicyDocumentDataSource()
+
+	var sch datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &sch)
+	source := types.ListValueMust(types.StringType, []attr.Value{types.StringValue(`{"permissions":[{"actions":["read"]}]}`)})
+
+	var resp datasource.ReadResponse
+	initDataSourceState(t, &resp.State, sch.Schema)
+	d.Read(ctx, datasource.ReadRequest{Config: dataSourceConfig(t, sch.Schema, map[string]attr.Value{"source_policy_documents": source})}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+
+	var got jsonString
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("json"), &got)...)
+	if got.ValueString() != `{"permissions":[{"actions":["read"]}],"version":"1.0"}` {
+		t.Fatalf("unexpected json: %s", got.ValueString())
+	}
+}
@@ -0,0 +1,287 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type iamPolicySimulationDataSourceModel struct {
+	PolicyDocuments []types.String                 `tfsdk:"policy_documents"`
+	Probes          []iamPolicySimulationProbe     `tfsdk:"probe"`
+	Results         []iamPolicySimulationResultRow `tfsdk:"results"`
+	AllAllowed      types.Bool                     `tfsdk:"all_allowed"`
+}
+
+type iamPolicySimulationProbe struct {
+	Action       types.String `tfsdk:"action"`
+	Scope        types.String `tfsdk:"scope"`
+	Region       types.String `tfsdk:"region"`
+	Service      types.String `tfsdk:"service"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	ResourceID   types.String `tfsdk:"resource_id"`
+}
+
+type iamPolicySimulationResultRow struct {
+	Action           types.String `tfsdk:"action"`
+	Scope            types.String `tfsdk:"scope"`
+	Region           types.String `tfsdk:"region"`
+	Service          types.String `tfsdk:"service"`
+	ResourceType     types.String `tfsdk:"resource_type"`
+	ResourceID       types.String `tfsdk:"resource_id"`
+	Decision         types.String `tfsdk:"decision"`
+	Allowed          types.Bool   `tfsdk:"allowed"`
+	MatchedStatement types.String `tfsdk:"matched_statement"`
+	MatchedSid       types.String `tfsdk:"matched_sid"`
+}
+
+type IamPolicySimulationDataSource struct{}
+
+func NewIamPolicySimulationDataSource() datasource.DataSource {
+	return &IamPolicySimulationDataSource{}
+}
+
+var _ datasource.DataSource = (*IamPolicySimulationDataSource)(nil)
+
+func (d *IamPolicySimulationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_policy_simulation"
+}
+
+func (d *IamPolicySimulationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	computedString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{Computed: true, Description: description}
+	}
+	resp.Schema = schema.Schema{
+		Description: "Evaluate policy documents against action and resource probes. The evaluation runs in the provider; nothing is sent to the API.",
+		Attributes: map[string]schema.Attribute{
+			"policy_documents": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Policy documents evaluated together, e.g. the document of an account or managed policy and an inline_policy_document.",
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "One result per probe, in probe order.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"action":        computedString("Action of the probe."),
+						"scope":         computedString("Scope of the probe."),
+						"region":        computedString("Region of the probe."),
+						"service":       computedString("Service of the probe."),
+						"resource_type": computedString("Resource type of the probe."),
+						"resource_id":   computedString("Resource ID of the probe."),
+						"decision":      computedString("allow, deny (an explicit deny matched) or implicit_deny (nothing matched)."),
+						"allowed": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether decision is allow.",
+						},
+						"matched_statement": computedString("Location of the deciding permission, e.g. policy_documents[0].permissions[1]. Null for implicit_deny."),
+						"matched_sid":       computedString("sid of the deciding permission, if it has one."),
+					},
+				},
+			},
+			"all_allowed": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether every probe is allowed.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"probe": schema.ListNestedBlock{
+				Description: "A request to evaluate.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"action": schema.StringAttribute{
+							Required:    true,
+							Description: "Action, e.g. read or delete.",
+						},
+						"scope": schema.StringAttribute{
+							Optional:    true,
+							Description: "Scope of the request. Defaults to management.",
+						},
+						"region": schema.StringAttribute{
+							Required:    true,
+							Description: "Region of the resource, e.g. global or eu.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "Service of the resource, e.g. iam or gateway.",
+						},
+						"resource_type": schema.StringAttribute{
+							Required:    true,
+							Description: "Resource type within the service, e.g. instance.",
+						},
+						"resource_id": schema.StringAttribute{
+							Required:    true,
+							Description: "Resource ID.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IamPolicySimulationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config iamPolicySimulationDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	documents := make([][]simulatedPermission, 0, len(config.PolicyDocuments))
+	for i, raw := range config.PolicyDocuments {
+		doc, diags := parseSimulatedDocument(raw.ValueString(), path.Root("policy_documents").AtListIndex(i))
+		resp.Diagnostics.Append(diags...)
+		documents = append(documents, doc)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := config
+	state.Results = make([]iamPolicySimulationResultRow, 0, len(config.Probes))
+	allAllowed := true
+	for _, p := range config.Probes {
+		probe := simulationProbe{
+			action:       p.Action.ValueString(),
+			scope:        firstNonEmpty(p.Scope.ValueString(), "management"),
+			region:       p.Region.ValueString(),
+			service:      p.Service.ValueString(),
+			resourceType: p.ResourceType.ValueString(),
+			resourceID:   p.ResourceID.ValueString(),
+		}
+		result := simulatePolicies(documents, probe)
+		allAllowed = allAllowed && result.decision == "allow"
+
+		row := iamPolicySimulationResultRow{
+			Action:           types.StringValue(probe.action),
+			Scope:            types.StringValue(probe.scope),
+			Region:           types.StringValue(probe.region),
+			Service:          types.StringValue(probe.service),
+			ResourceType:     types.StringValue(probe.resourceType),
+			ResourceID:       types.StringValue(probe.resourceID),
+			Decision:         types.StringValue(result.decision),
+			Allowed:          types.BoolValue(result.decision == "allow"),
+			MatchedStatement: types.StringNull(),
+			MatchedSid:       types.StringNull(),
+		}
+		if result.matched != nil {
+			row.MatchedStatement = types.StringValue(fmt.Sprintf("policy_documents[%d].permissions[%d]", result.document, result.permission))
+			if result.matched.Sid != "" {
+				row.MatchedSid = types.StringValue(result.matched.Sid)
+			}
+		}
+		state.Results = append(state.Results, row)
+	}
+	state.AllAllowed = types.BoolValue(allAllowed)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+type simulatedPermission struct {
+	Sid        string                    `json:"sid"`
+	Effect     string                    `json:"effect"`
+	Scope      string                    `json:"scope"`
+	Actions    []string                  `json:"actions"`
+	Resources  []simulatedPolicyResource `json:"resources"`
+	Conditions []map[string]any          `json:"conditions"`
+}
+
+type simulatedPolicyResource struct {
+	Region       string `json:"region"`
+	Service      string `json:"service"`
+	ResourceType string `json:"resourceType"`
+	ResourceID   string `json:"resourceId"`
+}
+
+type simulationProbe struct {
+	action, scope, region, service, resourceType, resourceID string
+}
+
+type simulationResult struct {
+	decision   string
+	matched    *simulatedPermission
+	document   int
+	permission int
+}
+
+// parseSimulatedDocument runs the plan-time policy checks on a document before decoding it, so
+// the simulation only ever sees documents the API would accept.
+func parseSimulatedDocument(raw string, p path.Path) ([]simulatedPermission, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var doc any
+	if err := json.Unmarshal([]byte(raw), &doc); err != nil {
+		diags.AddAttributeError(p, "Invalid policy document", "value must be valid JSON: "+err.Error())
+		return nil, diags
+	}
+	problems, warnings := validatePolicyDocument(doc)
+	for _, problem := range problems {
+		diags.AddAttributeError(p, "Invalid policy document", problem)
+	}
+	for _, warning := range warnings {
+		diags.AddAttributeWarning(p, "Unknown policy action", warning)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var typed struct {
+		Permissions []simulatedPermission `json:"permissions"`
+	}
+	if err := json.Unmarshal([]byte(raw), &typed); err != nil {
+		diags.AddAttributeError(p, "Invalid policy document", err.Error())
+		return nil, diags
+	}
+	return typed.Permissions, diags
+}
+
+// simulatePolicies evaluates a probe against every permission of every document. An explicit
+// deny wins over any allow, and a request nothing allows is denied implicitly. Conditions are
+// not evaluated: a conditional allow is assumed to apply and a conditional deny is assumed not
+// to, so the result is the most the documents could grant.
+func simulatePolicies(documents [][]simulatedPermission, probe simulationProbe) simulationResult {
+	result := simulationResult{decision: "implicit_deny"}
+	for d, permissions := range documents {
+		for i := range permissions {
+			permission := &permissions[i]
+			if !permission.matches(probe) {
+				continue
+			}
+			switch {
+			case permission.Effect == "deny" && len(permission.Conditions) == 0:
+				return simulationResult{decision: "deny", matched: permission, document: d, permission: i}
+			case permission.Effect == "allow" && result.matched == nil:
+				result = simulationResult{decision: "allow", matched: permission, document: d, permission: i}
+			}
+		}
+	}
+	return result
+}
+
+func (p *simulatedPermission) matches(probe simulationProbe) bool {
+	if !policyValueMatches(p.Scope, probe.scope) {
+		return false
+	}
+	if !slices.Contains(p.Actions, "*") && !slices.Contains(p.Actions, probe.action) {
+		return false
+	}
+	for _, r := range p.Resources {
+		if policyValueMatches(r.Region, probe.region) &&
+			policyValueMatches(r.Service, probe.service) &&
+			policyValueMatches(r.ResourceType, probe.resourceType) &&
+			policyValueMatches(r.ResourceID, probe.resourceID) {
+			return true
+		}
+	}
+	return false
+}
+
+func policyValueMatches(pattern, value string) bool {
+	return pattern == "*" || pattern == value
+}
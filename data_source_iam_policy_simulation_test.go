@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func simulatedDocument(t *testing.T, raw string) []simulatedPermission {
+	t.Helper()
+	permissions, diags := parseSimulatedDocument(raw, path.Root("policy_documents").AtListIndex(0))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", diags)
+	}
+	return permissions
+}
+
+func TestSimulatePolicies_Decisions(t *testing.T) {
+	managed := simulatedDocument(t, `{"permissions":[`+
+		`{"effect":"allow","scope":"management","actions":["*"],"resources":[{"region":"*","service":"gateway","resourceType":"*","resourceId":"*"}]},`+
+		`{"effect":"allow","scope":"management","actions":["read"],"resources":[{"region":"global","service":"iam","resourceType":"*","resourceId":"*"}],"conditions":[{"operator":"StringEquals","key":"region","values":["eu"]}]}`+
+		`]}`)
+	inline := simulatedDocument(t, `{"permissions":[`+
+		`{"sid":"keep-prod","effect":"deny","scope":"management","actions":["delete"],"resources":[{"region":"eu","service":"gateway","resourceType":"instance","resourceId":"prod"}]},`+
+		`{"sid":"maybe","effect":"deny","scope":"management","actions":["read"],"resources":[{"region":"*","service":"*","resourceType":"*","resourceId":"*"}],"conditions":[{"operator":"StringEquals","key":"region","values":["us"]}]}`+
+		`]}`)
+	documents := [][]simulatedPermission{managed, inline}
+
+	cases := []struct {
+		name       string
+		probe      simulationProbe
+		decision   string
+		document   int
+		permission int
+	}{
+		{"wildcard allow", simulationProbe{"update", "management", "eu", "gateway", "instance", "staging"}, "allow", 0, 0},
+		{"explicit deny wins", simulationProbe{"delete", "management", "eu", "gateway", "instance", "prod"}, "deny", 1, 0},
+		{"conditional allow applies", simulationProbe{"read", "management", "global", "iam", "policy", "readonly"}, "allow", 0, 1},
+		{"nothing matches", simulationProbe{"delete", "management", "global", "iam", "policy", "readonly"}, "implicit_deny", 0, 0},
+		{"other scope", simulationProbe{"update", "service", "eu", "gateway", "instance", "staging"}, "implicit_deny", 0, 0},
+	}
+	for _, tc := range cases {
+		got := simulatePolicies(documents, tc.probe)
+		if got.decision != tc.decision {
+			t.Fatalf("%s: expected %s, got %s", tc.name, tc.decision, got.decision)
+		}
+		if tc.decision == "implicit_deny" {
+			if got.matched != nil {
+				t.Fatalf("%s: expected no matched statement", tc.name)
+			}
+			continue
+		}
+		if got.document != tc.document || got.permission != tc.permission {
+			t.Fatalf("%s: expected policy_documents[%d].permissions[%d], got [%d].[%d]", tc.name, tc.document, tc.permission, got.document, got.permission)
+		}
+	}
+}
+
+func TestIamPolicySimulationDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	d := NewIamPolicySimulationDataSource()
+
+	var sch datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &sch)
+
+	probeType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"action": types.StringType, "scope": types.StringType, "region": types.StringType,
+		"service": types.StringType, "resource_type": types.StringType, "resource_id": types.StringType,
+	}}
+	probe := func(action string) attr.Value {
+		return types.ObjectValueMust(probeType.AttrTypes, map[string]attr.Value{
+			"action":        types.StringValue(action),
+			"scope":         types.StringNull(),
+			"region":        types.StringValue("eu"),
+			"service":       types.StringValue("gateway"),
+			"resource_type": types.StringValue("instance"),
+			"resource_id":   types.StringValue("prod"),
+		})
+	}
+	documents := types.ListValueMust(types.StringType, []attr.Value{types.StringValue(
+		`{"permissions":[{"sid":"gateway-read","effect":"allow","scope":"management","actions":["read","list"],"resources":[{"region":"*","service":"gateway","resourceType":"*","resourceId":"*"}]}]}`,
+	)})
+
+	var resp datasource.ReadResponse
+	initDataSourceState(t, &resp.State, sch.Schema)
+	d.Read(ctx, datasource.ReadRequest{Config: dataSourceConfig(t, sch.Schema, map[string]attr.Value{
+		"policy_documents": documents,
+		"probe":            types.ListValueMust(probeType, []attr.Value{probe("read"), probe("delete")}),
+	})}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+
+	var got iamPolicySimulationDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if got.AllAllowed.ValueBool() || len(got.Results) != 2 {
+		t.Fatalf("unexpected state: %+v", got)
+	}
+	read, del := got.Results[0], got.Results[1]
+	if !read.Allowed.ValueBool() || read.Scope.ValueString() != "management" ||
+		read.MatchedStatement.ValueString() != "policy_documents[0].permissions[0]" || read.MatchedSid.ValueString() != "gateway-read" {
+		t.Fatalf("unexpected read result: %+v", read)
+	}
+	if del.Decision.ValueString() != "implicit_deny" || !del.MatchedStatement.IsNull() || !del.MatchedSid.IsNull() {
+		t.Fatalf("unexpected delete result: %+v", del)
+	}
+}
+
+func TestIamPolicySimulationDataSource_Read_InvalidDocument(t *testing.T) {
+	ctx := context.Background()
+	d := NewIamPolicySimulationDataSource()
+
+	var sch datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &sch)
+	documents := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue(`{"permissions":[]}`),
+		types.StringValue(`{"permissions":[{"effect":"permit","scope":"management","actions":["read"],"resources":[]}]}`),
+	})
+
+	var resp datasource.ReadResponse
+	initDataSourceState(t, &resp.State, sch.Schema)
+	d.Read(ctx, datasource.ReadRequest{Config: dataSourceConfig(t, sch.Schema, map[string]attr.Value{"policy_documents": documents})}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected diagnostics error")
+	}
+	withPath, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+	if !ok || !withPath.Path().Equal(path.Root("policy_documents").AtListIndex(1)) {
+		t.Fatalf("expected error on the second document, got %#v", resp.Diagnostics.Errors()[0])
+	}
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// iamServiceRoleDataSourceModel mirrors iamServiceRoleModel without the resource-only timeouts block.
+type iamServiceRoleDataSourceModel struct {
+	ResourceID           types.String `tfsdk:"resource_id"`
+	Name                 types.String `tfsdk:"name"`
+	InlinePolicyDocument jsonString   `tfsdk:"inline_policy_document"`
+}
+
+type IamServiceRoleDataSource struct {
+	client *APIClient
+}
+
+func NewIamServiceRoleDataSource() datasource.DataSource {
+	return &IamServiceRoleDataSource{}
+}
+
+var _ datasource.DataSource = (*IamServiceRoleDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*IamServiceRoleDataSource)(nil)
+
+func (d *IamServiceRoleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_service_role"
+}
+
+func (d *IamServiceRoleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Look up an existing account-owned IAM service role by resource_id.",
+		Attributes: map[string]schema.Attribute{
+			"resource_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Service role resource ID.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Human readable service role name.",
+			},
+			"inline_policy_document": schema.StringAttribute{
+				CustomType:  jsonStringType{},
+				Computed:    true,
+				Description: "Inline policy document JSON (string) for this service role.",
+			},
+		},
+	}
+}
+
+func (d *IamServiceRoleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*APIClient)
+}
+
+func (d *IamServiceRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config iamServiceRoleDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceID, ok := requireKnownString(&resp.Diagnostics, config.ResourceID, path.Root("resource_id"), "resource_id")
+	if !ok {
+		return
+	}
+
+	var serviceRole iamServiceRoleModel
+	found, diags := (&IamServiceRoleResource{client: d.client}).readIntoState(ctx, resourceID, &serviceRole)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddAttributeError(path.Root("resource_id"), "Service role not found", fmt.Sprintf("No service role with resource_id %q exists.", resourceID))
+		return
+	}
+
+	state := iamServiceRoleDataSourceModel{
+		ResourceID:           serviceRole.ResourceID,
+		Name:                 serviceRole.Name,
+		InlinePolicyDocument: serviceRole.InlinePolicyDocument,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
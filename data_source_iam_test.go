@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func readIamDataSource(t *testing.T, d datasource.DataSource, resourceID string) datasource.ReadResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	var sch datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &sch)
+
+	req := datasource.ReadRequest{Config: dataSourceConfig(t, sch.Schema, map[string]attr.Value{"resource_id": types.StringValue(resourceID)})}
+	var resp datasource.ReadResponse
+	initDataSourceState(t, &resp.State, sch.Schema)
+
+	d.Read(ctx, req, &resp)
+	return resp
+}
+
+func TestIamDataSources_Configure(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return httpResponse(200, nil, `{}`), nil
+	}))
+
+	apiKey := &IamApiKeyDataSource{}
+	policy := &IamPolicyDataSource{}
+	serviceRole := &IamServiceRoleDataSource{}
+	for _, d := range []datasource.DataSourceWithConfigure{apiKey, policy, serviceRole} {
+		d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: nil}, &datasource.ConfigureResponse{})
+		d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: c}, &datasource.ConfigureResponse{})
+	}
+	if apiKey.client == nil || policy.client == nil || serviceRole.client == nil {
+		t.Fatalf("expected clients set")
+	}
+}
+
+func TestIamApiKeyDataSource_Read_PopulatesStateWithoutSecret(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if got := r.URL.String(); got != "https://iam.management.global.example.com/api-keys/kid" {
+			return httpResponse(500, nil, "unexpected url: "+got), nil
+		}
+		return httpResponse(200, nil, `{"apiKey":{"resourceId":"kid","name":"k","inlinePolicyDocument":{"a":1}}}`), nil
+	}))
+
+	resp := readIamDataSource(t, &IamApiKeyDataSource{client: c}, "kid")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+
+	var got iamApiKeyDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+	if got.Name.ValueString() != "k" || got.InlinePolicyDocument.ValueString() != `{"a":1}` {
+		t.Fatalf("unexpected state: %+v", got)
+	}
+}
+
+func TestIamPolicyDataSource_Read_PopulatesState(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if got := r.URL.String(); got != "https://iam.management.global.example.com/policies/pid?policyType=account" {
+			return httpResponse(500, nil, "unexpected url: "+got), nil
+		}
+		return httpResponse(200, nil, `{"policy":{"resourceId":"pid","name":"p","document":{"a":1},"policyType":"account"}}`), nil
+	}))
+
+	resp := readIamDataSource(t, &IamPolicyDataSource{client: c}, "pid")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+
+	var got iamPolicyDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+	if got.Name.ValueString() != "p" || got.Document.ValueString() != `{"a":1}` {
+		t.Fatalf("unexpected state: %+v", got)
+	}
+}
+
+func TestIamServiceRoleDataSource_Read_PopulatesState(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return httpResponse(200, nil, `{"serviceRole":{"resourceId":"sid","name":"s","inlinePolicyDocument":null}}`), nil
+	}))
+
+	resp := readIamDataSource(t, &IamServiceRoleDataSource{client: c}, "sid")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+
+	var got iamServiceRoleDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+	if got.Name.ValueString() != "s" || !got.InlinePolicyDocument.IsNull() {
+		t.Fatalf("unexpected state: %+v", got)
+	}
+}
+
+func TestIamDataSources_Read_NotFoundAddsDiagnostics(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return httpResponse(404, nil, `{"code":"NotFound","message":"missing"}`), nil
+	}))
+
+	for _, d := range []datasource.DataSource{
+		&IamApiKeyDataSource{client: c},
+		&IamPolicyDataSource{client: c},
+		&IamServiceRoleDataSource{client: c},
+	} {
+		resp := readIamDataSource(t, d, "missing")
+		if !resp.Diagnostics.HasError() {
+			t.Fatalf("expected diagnostics error for %T", d)
+		}
+	}
+}
+
+func TestIamDataSources_Read_APIErrorAddsDiagnostics(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return httpResponse(400, nil, `{"code":"Bad","message":"nope"}`), nil
+	}))
+
+	for _, d := range []datasource.DataSource{
+		&IamApiKeyDataSource{client: c},
+		&IamPolicyDataSource{client: c},
+		&IamServiceRoleDataSource{client: c},
+	} {
+		resp := readIamDataSource(t, d, "rid")
+		if !resp.Diagnostics.HasError() {
+			t.Fatalf("expected diagnostics error for %T", d)
+		}
+	}
+}
+
+func TestIamDataSources_Read_EmptyResourceIDAddsDiagnostics(t *testing.T) {
+	var calls int
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		return httpResponse(500, nil, "unexpected"), nil
+	}))
+
+	for _, d := range []datasource.DataSource{
+		&IamApiKeyDataSource{client: c},
+		&IamPolicyDataSource{client: c},
+		&IamServiceRoleDataSource{client: c},
+	} {
+		resp := readIamDataSource(t, d, "")
+		if !resp.Diagnostics.HasError() {
+			t.Fatalf("expected diagnostics error for %T", d)
+		}
+	}
+	if calls != 0 {
+		t.Fatalf("expected no http calls, got %d", calls)
+	}
+}
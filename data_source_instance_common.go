@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// instanceDataSourceModel mirrors instanceModel without the resource-only timeouts block.
+type instanceDataSourceModel struct {
+	Region                  types.String `tfsdk:"region"`
+	ResourceID              types.String `tfsdk:"resource_id"`
+	Name                    types.String `tfsdk:"name"`
+	ConfigurationResourceID types.String `tfsdk:"configuration_resource_id"`
+	InlineConfiguration     jsonString   `tfsdk:"inline_configuration"`
+	Endpoint                types.String `tfsdk:"endpoint"`
+	Status                  types.String `tfsdk:"status"`
+}
+
+type instanceDataSource struct {
+	client  *APIClient
+	baseURL func(client *APIClient, region string) string
+}
+
+// Note: this is an embedded helper; the wrapper data sources implement Metadata/Schema.
+
+func (d *instanceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*APIClient)
+}
+
+func (d *instanceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config instanceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceID, ok := requireKnownString(&resp.Diagnostics, config.ResourceID, path.Root("resource_id"), "resource_id")
+	if !ok {
+		return
+	}
+
+	found, out, inlineJSON, diags := readInstanceIntoState(ctx, d.client, d.baseURL(d.client, config.Region.ValueString()), resourceID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddAttributeError(path.Root("resource_id"), "Instance not found", fmt.Sprintf("No instance with resource_id %q exists.", resourceID))
+		return
+	}
+
+	var instance instanceModel
+	instanceResponseToModel(out, inlineJSON, &instance)
+	state := instanceDataSourceModel{
+		Region:                  config.Region,
+		ResourceID:              instance.ResourceID,
+		Name:                    instance.Name,
+		ConfigurationResourceID: instance.ConfigurationResourceID,
+		InlineConfiguration:     instance.InlineConfiguration,
+		Endpoint:                instance.Endpoint,
+		Status:                  instance.Status,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func instanceDataSourceSchema(service string) schema.Schema {
+	return schema.Schema{
+		Description: "Look up an existing " + service + " instance by resource_id.",
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "Region the instance is in (e.g. eu). Defaults to the provider region.",
+				Validators:  []validator.String{regionValidator{}},
+			},
+			"resource_id": schema.StringAttribute{
+				Required:    true,
+				Description: titleCase(service) + " instance resource ID.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Human readable instance name.",
+			},
+			"configuration_resource_id": schema.StringAttribute{
+				Computed:    true,
+				Description: titleCase(service) + " configuration resource ID applied to this instance.",
+			},
+			"inline_configuration": schema.StringAttribute{
+				CustomType:  jsonStringType{},
+				Computed:    true,
+				Description: "Inline " + service + " configuration JSON (string).",
+			},
+			"endpoint": schema.StringAttribute{
+				Computed:    true,
+				Description: "Platform-reported endpoint (pass-through).",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Platform-reported instance status.",
+			},
+		},
+	}
+}
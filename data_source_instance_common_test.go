@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func readInstanceDataSource(t *testing.T, d *instanceDataSource, resourceID types.String) datasource.ReadResponse {
+	t.Helper()
+
+	s := instanceDataSourceSchema("gateway")
+	req := datasource.ReadRequest{Config: dataSourceConfig(t, s, map[string]attr.Value{"resource_id": resourceID})}
+	var resp datasource.ReadResponse
+	initDataSourceState(t, &resp.State, s)
+
+	d.Read(context.Background(), req, &resp)
+	return resp
+}
+
+func TestInstanceDataSource_Configure(t *testing.T) {
+	d := &instanceDataSource{}
+
+	d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: nil}, &datasource.ConfigureResponse{})
+	if d.client != nil {
+		t.Fatalf("expected nil client")
+	}
+
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return httpResponse(200, nil, `{}`), nil
+	}))
+	d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: c}, &datasource.ConfigureResponse{})
+	if d.client == nil {
+		t.Fatalf("expected client set")
+	}
+}
+
+func TestInstanceDataSource_Read_PopulatesState(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if r.Method != http.MethodGet {
+			return httpResponse(500, nil, "unexpected"), nil
+		}
+		if got := r.URL.String(); got != "https://gateway.management.eu.example.com/instances/rid" {
+			return httpResponse(500, nil, "unexpected url: "+got), nil
+		}
+		return httpResponse(200, nil, `{"instance":{"resourceId":"rid","name":"n","configurationResourceId":"cid","inlineConfiguration":{"a":1},"endpoint":"https://example.invalid"}}`), nil
+	}))
+
+	d := NewGatewayInstanceDataSource().(*GatewayInstanceDataSource)
+	d.client = c
+
+	resp := readInstanceDataSource(t, &d.instanceDataSource, types.StringValue("rid"))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+
+	var got instanceDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+	if got.Name.ValueString() != "n" || got.ConfigurationResourceID.ValueString() != "cid" {
+		t.Fatalf("unexpected state: %+v", got)
+	}
+	if got.InlineConfiguration.ValueString() != `{"a":1}` {
+		t.Fatalf("unexpected inline_configuration: %q", got.InlineConfiguration.ValueString())
+	}
+	if got.Endpoint.ValueString() != "https://example.invalid" {
+		t.Fatalf("unexpected endpoint: %q", got.Endpoint.ValueString())
+	}
+}
+
+func TestInstanceDataSource_Read_UsesRegion(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if got := r.URL.String(); got != "https://gateway.management.us.example.com/instances/rid" {
+			return httpResponse(500, nil, "unexpected url: "+got), nil
+		}
+		return httpResponse(200, nil, `{"instance":{"resourceId":"rid","name":"n"}}`), nil
+	}))
+
+	d := NewGatewayInstanceDataSource().(*GatewayInstanceDataSource)
+	d.client = c
+
+	s := instanceDataSourceSchema("gateway")
+	req := datasource.ReadRequest{Config: dataSourceConfig(t, s, map[string]attr.Value{"resource_id": types.StringValue("rid"), "region": types.StringValue("us")})}
+	var resp datasource.ReadResponse
+	initDataSourceState(t, &resp.State, s)
+	d.Read(context.Background(), req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+
+	var got instanceDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+	if got.Region.ValueString() != "us" || got.Name.ValueString() != "n" {
+		t.Fatalf("unexpected state: %+v", got)
+	}
+}
+
+func TestInstanceDataSource_Read_NotFoundAddsDiagnostics(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return httpResponse(404, nil, `{"code":"NotFound","message":"missing"}`), nil
+	}))
+
+	d := &instanceDataSource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
+	resp := readInstanceDataSource(t, d, types.StringValue("rid"))
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected diagnostics error")
+	}
+
+	var found bool
+	for _, e := range resp.Diagnostics.Errors() {
+		if e.Summary() == "Instance not found" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected not found diagnostic, got %#v", resp.Diagnostics)
+	}
+}
+
+func TestInstanceDataSource_Read_APIErrorAddsDiagnostics(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return httpResponse(400, nil, `{"code":"Bad","message":"nope"}`), nil
+	}))
+
+	d := &instanceDataSource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
+	resp := readInstanceDataSource(t, d, types.StringValue("rid"))
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected diagnostics error")
+	}
+}
+
+func TestInstanceDataSource_Read_UnknownResourceIDAddsDiagnostics(t *testing.T) {
+	var calls int
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		return httpResponse(500, nil, "unexpected"), nil
+	}))
+
+	d := &instanceDataSource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
+	resp := readInstanceDataSource(t, d, types.StringUnknown())
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected diagnostics error")
+	}
+	if calls != 0 {
+		t.Fatalf("expected no http calls, got %d", calls)
+	}
+
+	var p path.Path
+	for _, e := range resp.Diagnostics.Errors() {
+		if withPath, ok := e.(interface{ Path() path.Path }); ok {
+			p = withPath.Path()
+		}
+	}
+	if !p.Equal(path.Root("resource_id")) {
+		t.Fatalf("expected resource_id attribute error, got %v", p)
+	}
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type instancesDataSource struct {
+	client  *APIClient
+	baseURL func(client *APIClient, region string) string
+}
+
+type instancesDataSourceModel struct {
+	Region                  types.String           `tfsdk:"region"`
+	NamePrefix              types.String           `tfsdk:"name_prefix"`
+	Status                  types.String           `tfsdk:"status"`
+	ConfigurationResourceID types.String           `tfsdk:"configuration_resource_id"`
+	Instances               []instanceSummaryModel `tfsdk:"instances"`
+}
+
+type instanceSummaryModel struct {
+	ResourceID              types.String `tfsdk:"resource_id"`
+	Name                    types.String `tfsdk:"name"`
+	Status                  types.String `tfsdk:"status"`
+	ConfigurationResourceID types.String `tfsdk:"configuration_resource_id"`
+	Endpoint                types.String `tfsdk:"endpoint"`
+}
+
+// Note: this is an embedded helper; the wrapper data sources implement Metadata/Schema.
+
+func (d *instancesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*APIClient)
+}
+
+func (d *instancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config instancesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, diags := listInstances(ctx, d.client, d.baseURL(d.client, config.Region.ValueString()))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := config
+	state.Instances = filterInstances(items, config)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// filterInstances applies the optional filters client-side so they behave the same
+// regardless of which query parameters the list endpoint supports.
+func filterInstances(items []instanceListItem, filters instancesDataSourceModel) []instanceSummaryModel {
+	namePrefix := filters.NamePrefix.ValueString()
+	status := normalizeInstanceStatus(filters.Status.ValueString())
+	configurationResourceID := strings.TrimSpace(filters.ConfigurationResourceID.ValueString())
+
+	out := make([]instanceSummaryModel, 0, len(items))
+	for _, item := range items {
+		if namePrefix != "" && !strings.HasPrefix(item.Name, namePrefix) {
+			continue
+		}
+		if status != "" && normalizeInstanceStatus(item.Status) != status {
+			continue
+		}
+		if configurationResourceID != "" && item.ConfigurationResourceID != configurationResourceID {
+			continue
+		}
+
+		summary := instanceSummaryModel{
+			ResourceID:              types.StringValue(item.ResourceID),
+			Name:                    types.StringValue(item.Name),
+			Status:                  types.StringNull(),
+			ConfigurationResourceID: types.StringNull(),
+			Endpoint:                instanceEndpointToState(item.Endpoint),
+		}
+		if item.Status != "" {
+			summary.Status = instanceStatusToState(item.Status)
+		}
+		if item.ConfigurationResourceID != "" {
+			summary.ConfigurationResourceID = types.StringValue(item.ConfigurationResourceID)
+		}
+		out = append(out, summary)
+	}
+	return out
+}
+
+func instancesDataSourceSchema(service string) schema.Schema {
+	return schema.Schema{
+		Description: "List " + service + " instances, optionally filtered by name prefix, status and configuration.",
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "Region to list instances in (e.g. eu). Defaults to the provider region.",
+				Validators:  []validator.String{regionValidator{}},
+			},
+			"name_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return instances whose name starts with this prefix.",
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return instances with this status (e.g. RUNNING). Case-insensitive; READY and ACTIVE match RUNNING.",
+			},
+			"configuration_resource_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return instances using this " + service + " configuration.",
+			},
+			"instances": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Matching " + service + " instances.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_id": schema.StringAttribute{
+							Computed:    true,
+							Description: titleCase(service) + " instance resource ID.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Human readable instance name.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Platform-reported instance status.",
+						},
+						"configuration_resource_id": schema.StringAttribute{
+							Computed:    true,
+							Description: titleCase(service) + " configuration resource ID applied to this instance.",
+						},
+						"endpoint": schema.StringAttribute{
+							Computed:    true,
+							Description: "Platform-reported endpoint (pass-through).",
+						},
+					},
+				},
+			},
+		},
+	}
+}
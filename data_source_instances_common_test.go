@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func readInstancesDataSource(t *testing.T, d *instancesDataSource, filters map[string]attr.Value) (instancesDataSourceModel, datasource.ReadResponse) {
+	t.Helper()
+
+	s := instancesDataSourceSchema("authorizer")
+	req := datasource.ReadRequest{Config: dataSourceConfig(t, s, filters)}
+	var resp datasource.ReadResponse
+	initDataSourceState(t, &resp.State, s)
+
+	d.Read(context.Background(), req, &resp)
+
+	var got instancesDataSourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+	}
+	return got, resp
+}
+
+func instancesListClient() *APIClient {
+	return newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if r.URL.Path != "/instances" {
+			return httpResponse(500, nil, "unexpected path: "+r.URL.Path), nil
+		}
+		if r.URL.Query().Get("nextToken") == "" {
+			return httpResponse(200, nil, `{"instances":[
+				{"resourceId":"a1","name":"prod-auth-1","status":"RUNNING","configurationResourceId":"cfg","endpoint":"https://a1.invalid"},
+				{"resourceId":"a2","name":"prod-auth-2","status":"STOPPED","configurationResourceId":"cfg","endpoint":""}
+			],"nextToken":"p2"}`), nil
+		}
+		return httpResponse(200, nil, `{"instances":[
+			{"resourceId":"a3","name":"dev-auth-1","status":"running","configurationResourceId":"","endpoint":"https://a3.invalid"}
+		]}`), nil
+	}))
+}
+
+func TestInstancesDataSource_Configure(t *testing.T) {
+	d := &instancesDataSource{}
+
+	d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: nil}, &datasource.ConfigureResponse{})
+	if d.client != nil {
+		t.Fatalf("expected nil client")
+	}
+
+	d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: instancesListClient()}, &datasource.ConfigureResponse{})
+	if d.client == nil {
+		t.Fatalf("expected client set")
+	}
+}
+
+func TestInstancesDataSource_Read_NoFiltersReturnsAllPages(t *testing.T) {
+	d := &instancesDataSource{client: instancesListClient(), baseURL: func(*APIClient, string) string { return "https://example.com" }}
+
+	got, resp := readInstancesDataSource(t, d, nil)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+	if len(got.Instances) != 3 {
+		t.Fatalf("expected 3 instances, got %d", len(got.Instances))
+	}
+	if !got.Instances[1].Endpoint.IsNull() {
+		t.Fatalf("expected empty endpoint mapped to null")
+	}
+	if !got.Instances[2].ConfigurationResourceID.IsNull() {
+		t.Fatalf("expected empty configuration_resource_id mapped to null")
+	}
+}
+
+func TestInstancesDataSource_Read_AppliesFilters(t *testing.T) {
+	d := &instancesDataSource{client: instancesListClient(), baseURL: func(*APIClient, string) string { return "https://example.com" }}
+
+	got, resp := readInstancesDataSource(t, d, map[string]attr.Value{"status": types.StringValue("RUNNING")})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+	if len(got.Instances) != 2 || got.Instances[0].ResourceID.ValueString() != "a1" || got.Instances[1].ResourceID.ValueString() != "a3" {
+		t.Fatalf("unexpected status-filtered instances: %+v", got.Instances)
+	}
+
+	got, resp = readInstancesDataSource(t, d, map[string]attr.Value{
+		"name_prefix":               types.StringValue("prod-"),
+		"configuration_resource_id": types.StringValue("cfg"),
+		"status":                    types.StringValue("stopped"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+	if len(got.Instances) != 1 || got.Instances[0].ResourceID.ValueString() != "a2" {
+		t.Fatalf("unexpected filtered instances: %+v", got.Instances)
+	}
+	if got.Instances[0].Status.ValueString() != "STOPPED" {
+		t.Fatalf("unexpected status: %q", got.Instances[0].Status.ValueString())
+	}
+}
+
+func TestInstancesDataSource_Read_APIErrorAddsDiagnostics(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return httpResponse(400, nil, `{"code":"Bad","message":"nope"}`), nil
+	}))
+	d := &instancesDataSource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
+
+	_, resp := readInstancesDataSource(t, d, nil)
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected diagnostics error")
+	}
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+type ResolverConfigurationDataSource struct {
+	configurationDataSource
+}
+
+func NewResolverConfigurationDataSource() datasource.DataSource {
+	return &ResolverConfigurationDataSource{
+		configurationDataSource: configurationDataSource{
+			baseURL: func(client *APIClient, region string) string {
+				return client.regionalBaseURL("resolver", region)
+			},
+		},
+	}
+}
+
+var _ datasource.DataSource = (*ResolverConfigurationDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*ResolverConfigurationDataSource)(nil)
+
+func (d *ResolverConfigurationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resolver_configuration"
+}
+
+func (d *ResolverConfigurationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = configurationDataSourceSchema("resolver")
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+type ResolverConfigurationsDataSource struct {
+	configurationsDataSource
+}
+
+func NewResolverConfigurationsDataSource() datasource.DataSource {
+	return &ResolverConfigurationsDataSource{
+		configurationsDataSource: configurationsDataSource{
+			baseURL: func(client *APIClient, region string) string {
+				return client.regionalBaseURL("resolver", region)
+			},
+		},
+	}
+}
+
+var _ datasource.DataSource = (*ResolverConfigurationsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*ResolverConfigurationsDataSource)(nil)
+
+func (d *ResolverConfigurationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resolver_configurations"
+}
+
+func (d *ResolverConfigurationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = configurationsDataSourceSchema("resolver")
+}
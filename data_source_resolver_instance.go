@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+type ResolverInstanceDataSource struct {
+	instanceDataSource
+}
+
+func NewResolverInstanceDataSource() datasource.DataSource {
+	return &ResolverInstanceDataSource{
+		instanceDataSource: instanceDataSource{
+			baseURL: func(client *APIClient, region string) string {
+				return client.regionalBaseURL("resolver", region)
+			},
+		},
+	}
+}
+
+var _ datasource.DataSource = (*ResolverInstanceDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*ResolverInstanceDataSource)(nil)
+
+func (d *ResolverInstanceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resolver_instance"
+}
+
+func (d *ResolverInstanceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = instanceDataSourceSchema("resolver")
+}
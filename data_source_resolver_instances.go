@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+type ResolverInstancesDataSource struct {
+	instancesDataSource
+}
+
+func NewResolverInstancesDataSource() datasource.DataSource {
+	return &ResolverInstancesDataSource{
+		instancesDataSource: instancesDataSource{
+			baseURL: func(client *APIClient, region string) string {
+				return client.regionalBaseURL("resolver", region)
+			},
+		},
+	}
+}
+
+var _ datasource.DataSource = (*ResolverInstancesDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*ResolverInstancesDataSource)(nil)
+
+func (d *ResolverInstancesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resolver_instances"
+}
+
+func (d *ResolverInstancesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = instancesDataSourceSchema("resolver")
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+type ValidatorConfigurationDataSource struct {
+	configurationDataSource
+}
+
+func NewValidatorConfigurationDataSource() datasource.DataSource {
+	return &ValidatorConfigurationDataSource{
+		configurationDataSource: configurationDataSource{
+			baseURL: func(client *APIClient, region string) string {
+				return client.regionalBaseURL("validator", region)
+			},
+		},
+	}
+}
+
+var _ datasource.DataSource = (*ValidatorConfigurationDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*ValidatorConfigurationDataSource)(nil)
+
+func (d *ValidatorConfigurationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_validator_configuration"
+}
+
+func (d *ValidatorConfigurationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = configurationDataSourceSchema("validator")
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+type ValidatorConfigurationsDataSource struct {
+	configurationsDataSource
+}
+
+func NewValidatorConfigurationsDataSource() datasource.DataSource {
+	return &ValidatorConfigurationsDataSource{
+		configurationsDataSource: configurationsDataSource{
+			baseURL: func(client *APIClient, region string) string {
+				return client.regionalBaseURL("validator", region)
+			},
+		},
+	}
+}
+
+var _ datasource.DataSource = (*ValidatorConfigurationsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*ValidatorConfigurationsDataSource)(nil)
+
+func (d *ValidatorConfigurationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_validator_configurations"
+}
+
+func (d *ValidatorConfigurationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = configurationsDataSourceSchema("validator")
+}
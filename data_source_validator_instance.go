@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+type ValidatorInstanceDataSource struct {
+	instanceDataSource
+}
+
+func NewValidatorInstanceDataSource() datasource.DataSource {
+	return &ValidatorInstanceDataSource{
+		instanceDataSource: instanceDataSource{
+			baseURL: func(client *APIClient, region string) string {
+				return client.regionalBaseURL("validator", region)
+			},
+		},
+	}
+}
+
+var _ datasource.DataSource = (*ValidatorInstanceDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*ValidatorInstanceDataSource)(nil)
+
+func (d *ValidatorInstanceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_validator_instance"
+}
+
+func (d *ValidatorInstanceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = instanceDataSourceSchema("validator")
+}
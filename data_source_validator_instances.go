@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+type ValidatorInstancesDataSource struct {
+	instancesDataSource
+}
+
+func NewValidatorInstancesDataSource() datasource.DataSource {
+	return &ValidatorInstancesDataSource{
+		instancesDataSource: instancesDataSource{
+			baseURL: func(client *APIClient, region string) string {
+				return client.regionalBaseURL("validator", region)
+			},
+		},
+	}
+}
+
+var _ datasource.DataSource = (*ValidatorInstancesDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*ValidatorInstancesDataSource)(nil)
+
+func (d *ValidatorInstancesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_validator_instances"
+}
+
+func (d *ValidatorInstancesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = instancesDataSourceSchema("validator")
+}
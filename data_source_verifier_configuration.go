@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+type VerifierConfigurationDataSource struct {
+	configurationDataSource
+}
+
+func NewVerifierConfigurationDataSource() datasource.DataSource {
+	return &VerifierConfigurationDataSource{
+		configurationDataSource: configurationDataSource{
+			baseURL: func(client *APIClient, region string) string {
+				return client.regionalBaseURL("verifier", region)
+			},
+		},
+	}
+}
+
+var _ datasource.DataSource = (*VerifierConfigurationDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*VerifierConfigurationDataSource)(nil)
+
+func (d *VerifierConfigurationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_verifier_configuration"
+}
+
+func (d *VerifierConfigurationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = configurationDataSourceSchema("verifier")
+}
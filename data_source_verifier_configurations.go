@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+type VerifierConfigurationsDataSource struct {
+	configurationsDataSource
+}
+
+func NewVerifierConfigurationsDataSource() datasource.DataSource {
+	return &VerifierConfigurationsDataSource{
+		configurationsDataSource: configurationsDataSource{
+			baseURL: func(client *APIClient, region string) string {
+				return client.regionalBaseURL("verifier", region)
+			},
+		},
+	}
+}
+
+var _ datasource.DataSource = (*VerifierConfigurationsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*VerifierConfigurationsDataSource)(nil)
+
+func (d *VerifierConfigurationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_verifier_configurations"
+}
+
+func (d *VerifierConfigurationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = configurationsDataSourceSchema("verifier")
+}
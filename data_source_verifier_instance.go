@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+type VerifierInstanceDataSource struct {
+	instanceDataSource
+}
+
+func NewVerifierInstanceDataSource() datasource.DataSource {
+	return &VerifierInstanceDataSource{
+		instanceDataSource: instanceDataSource{
+			baseURL: func(client *APIClient, region string) string {
+				return client.regionalBaseURL("verifier", region)
+			},
+		},
+	}
+}
+
+var _ datasource.DataSource = (*VerifierInstanceDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*VerifierInstanceDataSource)(nil)
+
+func (d *VerifierInstanceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_verifier_instance"
+}
+
+func (d *VerifierInstanceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = instanceDataSourceSchema("verifier")
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+type VerifierInstancesDataSource struct {
+	instancesDataSource
+}
+
+func NewVerifierInstancesDataSource() datasource.DataSource {
+	return &VerifierInstancesDataSource{
+		instancesDataSource: instancesDataSource{
+			baseURL: func(client *APIClient, region string) string {
+				return client.regionalBaseURL("verifier", region)
+			},
+		},
+	}
+}
+
+var _ datasource.DataSource = (*VerifierInstancesDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*VerifierInstancesDataSource)(nil)
+
+func (d *VerifierInstancesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_verifier_instances"
+}
+
+func (d *VerifierInstancesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = instancesDataSourceSchema("verifier")
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func TestDataSourceWrappers_MetadataAndSchemaSmoke(t *testing.T) {
+	ctx := context.Background()
+	providerType := "vidos"
+
+	tests := []struct {
+		name string
+		new  func() datasource.DataSource
+		suf  string
+	}{
+		{"iam_api_key", NewIamApiKeyDataSource, "_iam_api_key"},
+		{"iam_policy", NewIamPolicyDataSource, "_iam_policy"},
+		{"iam_service_role", NewIamServiceRoleDataSource, "_iam_service_role"},
+		{"iam_managed_policy", NewIamManagedPolicyDataSource, "_iam_managed_policy"},
+		{"resolver_configuration", NewResolverConfigurationDataSource, "_resolver_configuration"},
+		{"resolver_instance", NewResolverInstanceDataSource, "_resolver_instance"},
+		{"verifier_configuration", NewVerifierConfigurationDataSource, "_verifier_configuration"},
+		{"verifier_instance", NewVerifierInstanceDataSource, "_verifier_instance"},
+		{"validator_configuration", NewValidatorConfigurationDataSource, "_validator_configuration"},
+		{"validator_instance", NewValidatorInstanceDataSource, "_validator_instance"},
+		{"authorizer_configuration", NewAuthorizerConfigurationDataSource, "_authorizer_configuration"},
+		{"authorizer_instance", NewAuthorizerInstanceDataSource, "_authorizer_instance"},
+		{"gateway_configuration", NewGatewayConfigurationDataSource, "_gateway_configuration"},
+		{"gateway_instance", NewGatewayInstanceDataSource, "_gateway_instance"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			d := tc.new()
+
+			var meta datasource.MetadataResponse
+			d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: providerType}, &meta)
+			if meta.TypeName != providerType+tc.suf {
+				t.Fatalf("unexpected type name: %q", meta.TypeName)
+			}
+
+			var sch datasource.SchemaResponse
+			d.Schema(ctx, datasource.SchemaRequest{}, &sch)
+			rid, ok := sch.Schema.Attributes["resource_id"]
+			if !ok || !rid.IsRequired() {
+				t.Fatalf("expected required resource_id attribute")
+			}
+			if strings.TrimSpace(sch.Schema.Description) == "" {
+				t.Fatalf("expected schema description")
+			}
+		})
+	}
+}
+
+func TestListDataSourceWrappers_MetadataAndSchemaSmoke(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		new   func() datasource.DataSource
+		name  string
+		items string
+	}{
+		{NewResolverConfigurationsDataSource, "vidos_resolver_configurations", "configurations"},
+		{NewResolverInstancesDataSource, "vidos_resolver_instances", "instances"},
+		{NewVerifierConfigurationsDataSource, "vidos_verifier_configurations", "configurations"},
+		{NewVerifierInstancesDataSource, "vidos_verifier_instances", "instances"},
+		{NewValidatorConfigurationsDataSource, "vidos_validator_configurations", "configurations"},
+		{NewValidatorInstancesDataSource, "vidos_validator_instances", "instances"},
+		{NewAuthorizerConfigurationsDataSource, "vidos_authorizer_configurations", "configurations"},
+		{NewAuthorizerInstancesDataSource, "vidos_authorizer_instances", "instances"},
+		{NewGatewayConfigurationsDataSource, "vidos_gateway_configurations", "configurations"},
+		{NewGatewayInstancesDataSource, "vidos_gateway_instances", "instances"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			d := tc.new()
+
+			var meta datasource.MetadataResponse
+			d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "vidos"}, &meta)
+			if meta.TypeName != tc.name {
+				t.Fatalf("unexpected type name: %q", meta.TypeName)
+			}
+
+			var sch datasource.SchemaResponse
+			d.Schema(ctx, datasource.SchemaRequest{}, &sch)
+			if _, ok := sch.Schema.Attributes[tc.items]; !ok {
+				t.Fatalf("expected %s attribute", tc.items)
+			}
+			if _, ok := sch.Schema.Attributes["name_prefix"]; !ok {
+				t.Fatalf("expected name_prefix attribute")
+			}
+		})
+	}
+}
+
+func TestListDataSourceWrappers_BaseURLWiring(t *testing.T) {
+	client := &APIClient{cfg: providerConfig{domain: "example.com", defaultRegion: "eu"}}
+
+	bases := map[string]func(*APIClient, string) string{
+		"resolver":   NewResolverInstancesDataSource().(*ResolverInstancesDataSource).baseURL,
+		"verifier":   NewVerifierInstancesDataSource().(*VerifierInstancesDataSource).baseURL,
+		"validator":  NewValidatorInstancesDataSource().(*ValidatorInstancesDataSource).baseURL,
+		"authorizer": NewAuthorizerInstancesDataSource().(*AuthorizerInstancesDataSource).baseURL,
+		"gateway":    NewGatewayInstancesDataSource().(*GatewayInstancesDataSource).baseURL,
+	}
+	for service, base := range bases {
+		if got := base(client, ""); got != "https://"+service+".management.eu.example.com" {
+			t.Fatalf("unexpected %s instances baseURL: %q", service, got)
+		}
+		if got := base(client, "us"); got != "https://"+service+".management.us.example.com" {
+			t.Fatalf("unexpected %s instances baseURL in us: %q", service, got)
+		}
+	}
+
+	bases = map[string]func(*APIClient, string) string{
+		"resolver":   NewResolverConfigurationsDataSource().(*ResolverConfigurationsDataSource).baseURL,
+		"verifier":   NewVerifierConfigurationsDataSource().(*VerifierConfigurationsDataSource).baseURL,
+		"validator":  NewValidatorConfigurationsDataSource().(*ValidatorConfigurationsDataSource).baseURL,
+		"authorizer": NewAuthorizerConfigurationsDataSource().(*AuthorizerConfigurationsDataSource).baseURL,
+		"gateway":    NewGatewayConfigurationsDataSource().(*GatewayConfigurationsDataSource).baseURL,
+	}
+	for service, base := range bases {
+		if got := base(client, ""); got != "https://"+service+".management.eu.example.com" {
+			t.Fatalf("unexpected %s configurations baseURL: %q", service, got)
+		}
+		if got := base(client, "us"); got != "https://"+service+".management.us.example.com" {
+			t.Fatalf("unexpected %s configurations baseURL in us: %q", service, got)
+		}
+	}
+}
+
+func TestDataSourceWrappers_BaseURLWiring(t *testing.T) {
+	client := &APIClient{cfg: providerConfig{domain: "example.com", defaultRegion: "eu"}}
+
+	instances := map[string]func() datasource.DataSource{
+		"resolver":   NewResolverInstanceDataSource,
+		"verifier":   NewVerifierInstanceDataSource,
+		"validator":  NewValidatorInstanceDataSource,
+		"authorizer": NewAuthorizerInstanceDataSource,
+		"gateway":    NewGatewayInstanceDataSource,
+	}
+	for service, newFn := range instances {
+		d := newFn()
+		var base func(*APIClient, string) string
+		switch v := d.(type) {
+		case *ResolverInstanceDataSource:
+			base = v.baseURL
+		case *VerifierInstanceDataSource:
+			base = v.baseURL
+		case *ValidatorInstanceDataSource:
+			base = v.baseURL
+		case *AuthorizerInstanceDataSource:
+			base = v.baseURL
+		case *GatewayInstanceDataSource:
+			base = v.baseURL
+		}
+		if got := base(client, ""); got != "https://"+service+".management.eu.example.com" {
+			t.Fatalf("unexpected %s instance baseURL: %q", service, got)
+		}
+		if got := base(client, "us"); got != "https://"+service+".management.us.example.com" {
+			t.Fatalf("unexpected %s instance baseURL in us: %q", service, got)
+		}
+	}
+
+	configurations := map[string]func() datasource.DataSource{
+		"resolver":   NewResolverConfigurationDataSource,
+		"verifier":   NewVerifierConfigurationDataSource,
+		"validator":  NewValidatorConfigurationDataSource,
+		"authorizer": NewAuthorizerConfigurationDataSource,
+		"gateway":    NewGatewayConfigurationDataSource,
+	}
+	for service, newFn := range configurations {
+		d := newFn()
+		var base func(*APIClient, string) string
+		switch v := d.(type) {
+		case *ResolverConfigurationDataSource:
+			base = v.baseURL
+		case *VerifierConfigurationDataSource:
+			base = v.baseURL
+		case *ValidatorConfigurationDataSource:
+			base = v.baseURL
+		case *AuthorizerConfigurationDataSource:
+			base = v.baseURL
+		case *GatewayConfigurationDataSource:
+			base = v.baseURL
+		}
+		if got := base(client, ""); got != "https://"+service+".management.eu.example.com" {
+			t.Fatalf("unexpected %s configuration baseURL: %q", service, got)
+		}
+		if got := base(client, "us"); got != "https://"+service+".management.us.example.com" {
+			t.Fatalf("unexpected %s configuration baseURL in us: %q", service, got)
+		}
+	}
+}
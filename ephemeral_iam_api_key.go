@@ -0,0 +1,205 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// iamApiKeyPrivateKey is the private data key carrying the minted key's resource ID from Open to Close.
+const iamApiKeyPrivateKey = "api_key_resource_id"
+
+// IamApiKeyEphemeralResource mints an API key for the duration of a Terraform run. The key is
+// created on open and deleted on close, so its secret never reaches plan or state.
+type IamApiKeyEphemeralResource struct {
+	client *APIClient
+}
+
+type iamApiKeyEphemeralModel struct {
+	Name                 types.String                    `tfsdk:"name"`
+	InlinePolicyDocument jsonString                      `tfsdk:"inline_policy_document"`
+	Policies             []iamApiKeyEphemeralPolicyModel `tfsdk:"policies"`
+	ResourceID           types.String                    `tfsdk:"resource_id"`
+	ApiSecret            types.String                    `tfsdk:"api_secret"`
+}
+
+type iamApiKeyEphemeralPolicyModel struct {
+	PolicyType types.String `tfsdk:"policy_type"`
+	PolicyID   types.String `tfsdk:"policy_id"`
+}
+
+func NewIamApiKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &IamApiKeyEphemeralResource{}
+}
+
+var _ ephemeral.EphemeralResource = (*IamApiKeyEphemeralResource)(nil)
+var _ ephemeral.EphemeralResourceWithConfigure = (*IamApiKeyEphemeralResource)(nil)
+var _ ephemeral.EphemeralResourceWithClose = (*IamApiKeyEphemeralResource)(nil)
+
+func (r *IamApiKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_api_key"
+}
+
+func (r *IamApiKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Short-lived API key, created when opened and deleted when closed. The secret is never persisted in plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Human readable API key name.",
+			},
+			"inline_policy_document": schema.StringAttribute{
+				CustomType:  jsonStringType{},
+				Optional:    true,
+				Description: "Inline policy document JSON (string) for this API key.",
+				Validators:  []validator.String{policyDocumentValidator{}},
+			},
+			"policies": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Policies attached to the key after it is created.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"policy_type": schema.StringAttribute{
+							Required:    true,
+							Description: "Policy type. Can be account or managed.",
+							Validators:  []validator.String{stringOneOfValidator{values: []string{"account", "managed"}}},
+						},
+						"policy_id": schema.StringAttribute{
+							Required:    true,
+							Description: "Policy resource ID.",
+						},
+					},
+				},
+			},
+			"resource_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "API key resource ID.",
+			},
+			"api_secret": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "API key secret. Only valid until the ephemeral resource is closed.",
+			},
+		},
+	}
+}
+
+func (r *IamApiKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, _ *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*APIClient)
+}
+
+func (r *IamApiKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data iamApiKeyEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultCreateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.open(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceID, err := json.Marshal(data.ResourceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("resource_id encode error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, iamApiKeyPrivateKey, resourceID)...)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *IamApiKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	raw, diags := req.Private.GetKey(ctx, iamApiKeyPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || raw == nil {
+		return
+	}
+
+	var resourceID string
+	if err := json.Unmarshal(raw, &resourceID); err != nil {
+		resp.Diagnostics.AddError("resource_id decode error", err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultDeleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.close(ctx, resourceID)...)
+}
+
+// open creates the key and attaches its policies. A key that cannot be fully set up is deleted
+// again, since nothing would ever close it.
+func (r *IamApiKeyEphemeralResource) open(ctx context.Context, data *iamApiKeyEphemeralModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	apiKey := map[string]any{
+		"name": data.Name.ValueString(),
+	}
+	if !data.InlinePolicyDocument.IsNull() && !data.InlinePolicyDocument.IsUnknown() {
+		apiKey["inlinePolicyDocument"] = parseJSONToAny(&diags, data.InlinePolicyDocument.ValueString(), path.Root("inline_policy_document"), "inline_policy_document")
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	out, createDiags := createApiKey(ctx, r.client, apiKey)
+	diags.Append(createDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	resourceID := out.ApiKey.ResourceID
+
+	if out.ApiKey.ApiSecret == "" {
+		diags.AddError("API key secret missing", "The API did not return a secret for the created API key.")
+	}
+
+	attacher := &IamApiKeyPolicyAttachmentResource{client: r.client}
+	for i, p := range data.Policies {
+		if diags.HasError() {
+			break
+		}
+		policyType := strings.ToLower(p.PolicyType.ValueString())
+		policyID := p.PolicyID.ValueString()
+
+		attachDiags := attacher.getPolicy(ctx, policyType, policyID)
+		if !attachDiags.HasError() {
+			attachDiags.Append(attacher.attach(ctx, resourceID, policyType, policyID)...)
+		}
+		for _, d := range attachDiags {
+			diags.Append(diag.WithPath(path.Root("policies").AtListIndex(i), d))
+		}
+	}
+
+	if diags.HasError() {
+		// Use a fresh deadline: the open deadline may be what failed.
+		cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), defaultDeleteTimeout)
+		defer cancel()
+		diags.Append(deleteApiKey(cleanupCtx, r.client, resourceID)...)
+		return diags
+	}
+
+	data.ResourceID = types.StringValue(resourceID)
+	data.ApiSecret = types.StringValue(out.ApiKey.ApiSecret)
+	return diags
+}
+
+func (r *IamApiKeyEphemeralResource) close(ctx context.Context, resourceID string) diag.Diagnostics {
+	if resourceID == "" {
+		return nil
+	}
+	return deleteApiKey(ctx, r.client, resourceID)
+}
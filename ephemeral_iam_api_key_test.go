@@ -0,0 +1,241 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestIamApiKeyEphemeralResource_MetadataAndSchema(t *testing.T) {
+	r := NewIamApiKeyEphemeralResource()
+
+	var meta ephemeral.MetadataResponse
+	r.Metadata(context.Background(), ephemeral.MetadataRequest{ProviderTypeName: "vidos"}, &meta)
+	if meta.TypeName != "vidos_iam_api_key" {
+		t.Fatalf("unexpected type name: %q", meta.TypeName)
+	}
+
+	var sch ephemeral.SchemaResponse
+	r.Schema(context.Background(), ephemeral.SchemaRequest{}, &sch)
+	secret, ok := sch.Schema.Attributes["api_secret"]
+	if !ok || !secret.IsSensitive() || !secret.IsComputed() {
+		t.Fatalf("expected computed sensitive api_secret, got %#v", secret)
+	}
+}
+
+func TestIamApiKeyEphemeralResource_Open_CreatesKeyAndAttachesPolicies(t *testing.T) {
+	var gotCreate string
+	var attached []string
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api-keys":
+			b, _ := io.ReadAll(r.Body)
+			gotCreate = string(b)
+			return httpResponse(200, nil, `{"apiKey":{"resourceId":"ak","name":"ci","apiSecret":"s3cr3t"}}`), nil
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/policies/"):
+			return httpResponse(200, nil, `{}`), nil
+		case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/api-keys/ak/policies/"):
+			attached = append(attached, r.URL.Path+"?"+r.URL.RawQuery)
+			return httpResponse(200, nil, `{}`), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.String())
+			return nil, nil
+		}
+	}))
+
+	r := &IamApiKeyEphemeralResource{client: c}
+	data := iamApiKeyEphemeralModel{
+		Name:                 types.StringValue("ci"),
+		InlinePolicyDocument: jsonStringValue(`{"version":"1.0","permissions":[]}`),
+		Policies: []iamApiKeyEphemeralPolicyModel{
+			{PolicyType: types.StringValue("managed"), PolicyID: types.StringValue("p1")},
+			{PolicyType: types.StringValue("Account"), PolicyID: types.StringValue("p2")},
+		},
+	}
+
+	diags := r.open(context.Background(), &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", diags)
+	}
+	if data.ResourceID.ValueString() != "ak" || data.ApiSecret.ValueString() != "s3cr3t" {
+		t.Fatalf("unexpected result: %#v", data)
+	}
+
+	var body map[string]map[string]any
+	if err := json.Unmarshal([]byte(gotCreate), &body); err != nil {
+		t.Fatalf("create body: %s", err)
+	}
+	if body["apiKey"]["name"] != "ci" || body["apiKey"]["inlinePolicyDocument"] == nil {
+		t.Fatalf("unexpected create body: %s", gotCreate)
+	}
+
+	want := []string{
+		"/api-keys/ak/policies/p1?policyType=managed",
+		"/api-keys/ak/policies/p2?policyType=account",
+	}
+	if strings.Join(attached, ",") != strings.Join(want, ",") {
+		t.Fatalf("unexpected attachments: %v", attached)
+	}
+}
+
+func TestIamApiKeyEphemeralResource_Open_AttachFailureDeletesKey(t *testing.T) {
+	var deleted bool
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api-keys":
+			return httpResponse(200, nil, `{"apiKey":{"resourceId":"ak","name":"ci","apiSecret":"s3cr3t"}}`), nil
+		case r.Method == http.MethodGet && r.URL.Path == "/policies/missing":
+			return httpResponse(404, nil, `{"code":"NotFound"}`), nil
+		case r.Method == http.MethodDelete && r.URL.Path == "/api-keys/ak":
+			deleted = true
+			return httpResponse(204, nil, ""), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.String())
+			return nil, nil
+		}
+	}))
+
+	r := &IamApiKeyEphemeralResource{client: c}
+	data := iamApiKeyEphemeralModel{
+		Name: types.StringValue("ci"),
+		Policies: []iamApiKeyEphemeralPolicyModel{
+			{PolicyType: types.StringValue("account"), PolicyID: types.StringValue("missing")},
+		},
+	}
+
+	diags := r.open(context.Background(), &data)
+	if !diags.HasError() {
+		t.Fatalf("expected diagnostics error")
+	}
+	if !deleted {
+		t.Fatalf("expected partially set up key to be deleted")
+	}
+	withPath, ok := diags.Errors()[0].(interface{ Path() path.Path })
+	if !ok || !withPath.Path().Equal(path.Root("policies").AtListIndex(0)) {
+		t.Fatalf("expected error on policies[0], got %#v", diags.Errors()[0])
+	}
+	if !data.ApiSecret.IsNull() {
+		t.Fatalf("expected no secret on failure")
+	}
+}
+
+func TestIamApiKeyEphemeralResource_Close(t *testing.T) {
+	var calls int
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		if r.Method != http.MethodDelete || r.URL.Path != "/api-keys/ak" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.String())
+		}
+		return httpResponse(404, nil, `{"code":"NotFound"}`), nil
+	}))
+	r := &IamApiKeyEphemeralResource{client: c}
+
+	if diags := r.close(context.Background(), ""); diags.HasError() || calls != 0 {
+		t.Fatalf("expected empty id to be a no-op, got %#v (%d calls)", diags, calls)
+	}
+	if diags := r.close(context.Background(), "ak"); diags.HasError() {
+		t.Fatalf("expected already deleted key to be ignored, got %#v", diags)
+	}
+	if calls != 1 {
+		t.Fatalf("expected one delete, got %d", calls)
+	}
+}
+
+func TestIamApiKeyEphemeralResource_OpenThenCloseViaProviderServer(t *testing.T) {
+	var deletedPath string
+	orig := http.DefaultTransport
+	http.DefaultTransport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/whoami":
+			return httpResponse(200, nil, `{"callerIdentity":{"accountId":"acc","apiKeyId":"key"}}`), nil
+		case r.Method == http.MethodGet && r.URL.Path == "/api-versions":
+			return httpResponse(404, nil, `{"code":"NotFound"}`), nil
+		case r.Method == http.MethodPost && r.URL.Path == "/api-keys":
+			return httpResponse(200, nil, `{"apiKey":{"resourceId":"ak","name":"ci","apiSecret":"s3cr3t"}}`), nil
+		case r.Method == http.MethodDelete:
+			deletedPath = r.URL.Path
+			return httpResponse(204, nil, ""), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.String())
+			return nil, nil
+		}
+	})
+	t.Cleanup(func() { http.DefaultTransport = orig })
+
+	ctx := context.Background()
+	server, err := providerserver.NewProtocol6WithError(New())()
+	if err != nil {
+		t.Fatalf("provider server: %s", err)
+	}
+	ephemeralServer, ok := server.(tfprotov6.ProviderServerWithEphemeralResources)
+	if !ok {
+		t.Fatalf("provider server does not support ephemeral resources")
+	}
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema: %s", err)
+	}
+
+	providerType := schemas.Provider.ValueType().(tftypes.Object)
+	providerConfig, err := tfprotov6.NewDynamicValue(providerType, objectValue(providerType, map[string]tftypes.Value{
+		"region":  tftypes.NewValue(tftypes.String, "eu"),
+		"api_key": tftypes.NewValue(tftypes.String, "secret"),
+	}))
+	if err != nil {
+		t.Fatalf("provider config: %s", err)
+	}
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: &providerConfig})
+	if err != nil || len(configureResp.Diagnostics) != 0 {
+		t.Fatalf("ConfigureProvider: %v %#v", err, configureResp)
+	}
+
+	ephemeralType := schemas.EphemeralResourceSchemas["vidos_iam_api_key"].ValueType().(tftypes.Object)
+	config, err := tfprotov6.NewDynamicValue(ephemeralType, objectValue(ephemeralType, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "ci"),
+	}))
+	if err != nil {
+		t.Fatalf("ephemeral config: %s", err)
+	}
+	openResp, err := ephemeralServer.OpenEphemeralResource(ctx, &tfprotov6.OpenEphemeralResourceRequest{
+		TypeName: "vidos_iam_api_key",
+		Config:   &config,
+	})
+	if err != nil || len(openResp.Diagnostics) != 0 {
+		t.Fatalf("OpenEphemeralResource: %v %#v", err, openResp)
+	}
+
+	result, err := openResp.Result.Unmarshal(ephemeralType)
+	if err != nil {
+		t.Fatalf("result: %s", err)
+	}
+	var attrs map[string]tftypes.Value
+	if err := result.As(&attrs); err != nil {
+		t.Fatalf("result attributes: %s", err)
+	}
+	var secret string
+	if err := attrs["api_secret"].As(&secret); err != nil || secret != "s3cr3t" {
+		t.Fatalf("unexpected api_secret: %q (%v)", secret, err)
+	}
+
+	closeResp, err := ephemeralServer.CloseEphemeralResource(ctx, &tfprotov6.CloseEphemeralResourceRequest{
+		TypeName: "vidos_iam_api_key",
+		Private:  openResp.Private,
+	})
+	if err != nil || len(closeResp.Diagnostics) != 0 {
+		t.Fatalf("CloseEphemeralResource: %v %#v", err, closeResp)
+	}
+	if deletedPath != "/api-keys/ak" {
+		t.Fatalf("expected key to be deleted on close, got %q", deletedPath)
+	}
+}
@@ -59,61 +59,74 @@ eBjEc9MCMGKk27tss0KCk93qaRsZ7NuAGWMSun6mraePJ7PUpaYz2/6zztu51kYK6NftObq4fw==
 resource "vidos_validator_instance" "main" {
   name = "terraform-example-validator-instance"
 
-  inline_configuration = jsonencode({
+  # Typed form of inline_configuration; PEM anchors are parsed at plan time.
+  inline_settings = {
     policies = {
       # Enforce that issuers chain to one of these roots.
-      trustedIssuer = {
+      trusted_issuer = {
         skip = false
-        trustedIssuerRootCertificates = [
+        trusted_issuer_root_certificates = [
           { type = "predefined", tag = "vidos" },
           { type = "pem", pem = local.valera_test_certificate },
           { type = "pem", pem = local.multipaz_certificate },
         ]
       }
     }
-  })
+  }
 }
 
 resource "vidos_authorizer_instance" "main" {
   name = "terraform-example-authorizer-instance"
 
-  inline_configuration = jsonencode({
+  inline_settings = {
     policies = {
       validate = {
         skip = false
         validator = {
-          type       = "instance"
-          resourceId = vidos_validator_instance.main.resource_id
-          serviceRole = {
-            owner      = "managed"
-            resourceId = "validator_all_actions"
+          type        = "instance"
+          resource_id = vidos_validator_instance.main.resource_id
+          service_role = {
+            owner       = "managed"
+            resource_id = "validator_all_actions"
           }
         }
       }
-  })
+    }
+  }
 }
 
 resource "vidos_gateway_instance" "main" {
   name = "terraform-example-gateway-instance"
 
-  inline_configuration = jsonencode({
+  inline_settings = {
     cors = {
-      enabled      = true
-      allowHeaders = ["*"]
-      origin       = ["*"]
+      enabled       = true
+      allow_headers = ["*"]
+      origin        = ["*"]
     }
 
     paths = {
       # Requests to /auth/* are forwarded to the authorizer instance.
       auth = {
-        type       = "instance"
-        service    = "authorizer"
-        resourceId = vidos_authorizer_instance.main.resource_id
-        serviceRole = {
-          owner      = "managed"
-          resourceId = "authorizer_all_actions"
+        type        = "instance"
+        service     = "authorizer"
+        resource_id = vidos_authorizer_instance.main.resource_id
+        service_role = {
+          owner       = "managed"
+          resource_id = "authorizer_all_actions"
+        }
+      }
+
+      # Requests to /validate/* go straight to the validator instance.
+      validate = {
+        type        = "instance"
+        service     = "validator"
+        resource_id = vidos_validator_instance.main.resource_id
+        service_role = {
+          owner       = "managed"
+          resource_id = "validator_all_actions"
         }
       }
     }
-  })
+  }
 }
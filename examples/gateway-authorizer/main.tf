@@ -31,25 +31,27 @@ resource "vidos_authorizer_instance" "main" {
 
 resource "vidos_gateway_instance" "example" {
   name = "terraform-example-gateway-instance"
-  inline_configuration = jsonencode({
+
+  # Typed form of inline_configuration; typos in service or owner fail at plan time.
+  inline_settings = {
     cors = {
-      enabled      = true
-      allowHeaders = ["*"]
-      origin       = ["*"]
+      enabled       = true
+      allow_headers = ["*"]
+      origin        = ["*"]
     }
     paths = {
       # Requests to /auth/* are forwarded to the authorizer instance.
       auth = {
-        type       = "instance"
-        service    = "authorizer"
-        resourceId = vidos_authorizer_instance.main.resource_id
+        type        = "instance"
+        service     = "authorizer"
+        resource_id = vidos_authorizer_instance.main.resource_id
 
         # Managed service role used for gateway -> authorizer service-to-service auth.
-        serviceRole = {
-          owner      = "managed"
-          resourceId = "authorizer_all_actions"
+        service_role = {
+          owner       = "managed"
+          resource_id = "authorizer_all_actions"
         }
       }
     }
-  })
+  }
 }
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// gatewayPathServices are the services a gateway route may forward to.
+var gatewayPathServices = []string{"authorizer", "resolver", "validator", "verifier"}
+
+// gatewaySettingsSchemaAttribute is the typed alternative to the gateway configuration JSON:
+//
+//	{"cors": {...}, "paths": {"<name>": {"type", "service", "resourceId", "serviceRole"}}}
+func gatewaySettingsSchemaAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"cors": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "CORS settings applied by the gateway.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Whether CORS handling is enabled.",
+					},
+					"origin": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Description: "Allowed origins (use \"*\" to allow any).",
+					},
+					"allow_headers": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Description: "Request headers allowed in CORS requests.",
+					},
+					"allow_methods": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Description: "HTTP methods allowed in CORS requests.",
+					},
+					"expose_headers": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Description: "Response headers exposed to the browser.",
+					},
+					"max_age": schema.Int64Attribute{
+						Optional:    true,
+						Description: "How long (seconds) preflight results may be cached.",
+					},
+					"credentials": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Whether credentials are allowed in CORS requests.",
+					},
+				},
+			},
+			"paths": schema.MapNestedAttribute{
+				Optional:    true,
+				Description: "Routes keyed by path prefix (e.g. auth serves /auth/*).",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: gatewayPathAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func gatewayPathAttributes() map[string]schema.Attribute {
+	attrs := instanceReferenceSettingsAttributes()
+	attrs["service"] = schema.StringAttribute{
+		Required:    true,
+		Description: "Service the route forwards to: authorizer, resolver, validator or verifier.",
+		Validators:  []validator.String{stringOneOfValidator{values: gatewayPathServices}},
+	}
+	return attrs
+}
@@ -0,0 +1,261 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// iamManagementActions lists the known actions of each service in a management scope permission,
+// including those behind the IAM calls the provider makes itself (POST /api-keys/{id}/rotate,
+// POST /service-roles/{id}/assume, GET /whoami). "*" matches every action and every service.
+// The API may add actions before this list does, so an unknown action is only a warning.
+var iamManagementActions = map[string][]string{
+	"iam":        {"create", "read", "update", "delete", "list", "rotate", "assume", "whoami"},
+	"resolver":   {"create", "read", "update", "delete", "list"},
+	"verifier":   {"create", "read", "update", "delete", "list"},
+	"validator":  {"create", "read", "update", "delete", "list"},
+	"authorizer": {"create", "read", "update", "delete", "list"},
+	"gateway":    {"create", "read", "update", "delete", "list"},
+}
+
+var (
+	policyResourceTypeRegex = regexp.MustCompile(`^[a-z][a-zA-Z0-9_-]*$`)
+	policyResourceIDRegex   = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+)
+
+var _ validator.String = (*policyDocumentValidator)(nil)
+
+// policyDocumentValidator checks a policy document against the Vidos IAM policy language, so a
+// bad document fails at plan time instead of with an API error mid-apply.
+type policyDocumentValidator struct{}
+
+func (v policyDocumentValidator) Description(_ context.Context) string {
+	return "Value must be a Vidos IAM policy document: a permissions list whose entries have an effect of allow or deny, known actions and well-formed resources."
+}
+
+func (v policyDocumentValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v policyDocumentValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var doc any
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &doc); err != nil {
+		// Malformed JSON is reported by the jsonString type.
+		return
+	}
+	problems, warnings := validatePolicyDocument(doc)
+	for _, problem := range problems {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid policy document", problem)
+	}
+	for _, warning := range warnings {
+		resp.Diagnostics.AddAttributeWarning(req.Path, "Unknown policy action", warning)
+	}
+}
+
+// validatePolicyDocument returns one message per problem, each prefixed with the JSON path of
+// the offending value (e.g. $.permissions[0].effect), and likewise one warning per unknown
+// action. Keys the language doesn't define are left alone so documents written for newer API
+// versions still pass.
+func validatePolicyDocument(doc any) (problems, warnings []string) {
+	report := func(at, format string, args ...any) {
+		problems = append(problems, at+": "+fmt.Sprintf(format, args...))
+	}
+	warn := func(at, format string, args ...any) {
+		warnings = append(warnings, at+": "+fmt.Sprintf(format, args...))
+	}
+
+	root, ok := doc.(map[string]any)
+	if !ok {
+		report("$", "expected a JSON object, got %s", jsonKind(doc))
+		return problems, warnings
+	}
+
+	if version, ok := root["version"]; ok {
+		s, isString := version.(string)
+		if _, err := parseAPIVersion(s); !isString || err != nil {
+			report("$.version", "expected a version string like %q", defaultPolicyDocumentVersion)
+		}
+	}
+
+	permissions, ok := root["permissions"]
+	if !ok {
+		report("$", "missing required key %q", "permissions")
+		return problems, warnings
+	}
+	list, ok := permissions.([]any)
+	if !ok {
+		report("$.permissions", "expected an array, got %s", jsonKind(permissions))
+		return problems, warnings
+	}
+	for i, p := range list {
+		validatePolicyPermission(fmt.Sprintf("$.permissions[%d]", i), p, report, warn)
+	}
+	return problems, warnings
+}
+
+func validatePolicyPermission(at string, value any, report, warn func(at, format string, args ...any)) {
+	permission, ok := value.(map[string]any)
+	if !ok {
+		report(at, "expected an object, got %s", jsonKind(value))
+		return
+	}
+
+	if effect, ok := requiredPolicyString(at, permission, "effect", report); ok && effect != "allow" && effect != "deny" {
+		report(at+".effect", "%q is not supported; expected allow or deny", effect)
+	}
+	scope, _ := requiredPolicyString(at, permission, "scope", report)
+	if sid, ok := permission["sid"]; ok {
+		if _, isString := sid.(string); !isString {
+			report(at+".sid", "expected a string, got %s", jsonKind(sid))
+		}
+	}
+
+	var services []string
+	if resources, ok := policyArray(at, permission, "resources", report); ok {
+		for i, r := range resources {
+			if service, ok := validatePolicyResource(fmt.Sprintf("%s.resources[%d]", at, i), r, report); ok {
+				services = append(services, service)
+			}
+		}
+	}
+
+	actions, ok := policyArray(at, permission, "actions", report)
+	if ok && len(actions) == 0 {
+		report(at+".actions", "at least one action is required")
+	}
+	for i, a := range actions {
+		actionAt := fmt.Sprintf("%s.actions[%d]", at, i)
+		action, isString := a.(string)
+		if !isString {
+			report(actionAt, "expected a string, got %s", jsonKind(a))
+			continue
+		}
+		if scope != "management" || action == "*" {
+			continue
+		}
+		for _, service := range services {
+			if known := iamManagementActions[service]; known != nil && !slices.Contains(known, action) {
+				warn(actionAt, "%q is not a known action of service %s; expected one of %s or *", action, service, strings.Join(known, ", "))
+				break
+			}
+		}
+	}
+
+	if conditions, ok := permission["conditions"]; ok {
+		list, isList := conditions.([]any)
+		if !isList {
+			report(at+".conditions", "expected an array, got %s", jsonKind(conditions))
+			return
+		}
+		for i, c := range list {
+			validatePolicyCondition(fmt.Sprintf("%s.conditions[%d]", at, i), c, report)
+		}
+	}
+}
+
+// validatePolicyResource checks a resource identifier and returns its service when that is a
+// concrete, known service.
+func validatePolicyResource(at string, value any, report func(at, format string, args ...any)) (string, bool) {
+	resource, ok := value.(map[string]any)
+	if !ok {
+		report(at, "expected an object, got %s", jsonKind(value))
+		return "", false
+	}
+
+	if region, ok := requiredPolicyString(at, resource, "region", report); ok && region != "*" && region != "global" && !regionSlugRegex.MatchString(region) {
+		report(at+".region", "%q is not a region; expected *, global or a region slug such as eu", region)
+	}
+	if resourceType, ok := requiredPolicyString(at, resource, "resourceType", report); ok && resourceType != "*" && !policyResourceTypeRegex.MatchString(resourceType) {
+		report(at+".resourceType", "%q is not a resource type; expected * or a name such as instance", resourceType)
+	}
+	if resourceID, ok := requiredPolicyString(at, resource, "resourceId", report); ok && resourceID != "*" && !policyResourceIDRegex.MatchString(resourceID) {
+		report(at+".resourceId", "%q is not a resource ID; expected * or letters, digits, '-', '_' and '.'", resourceID)
+	}
+
+	service, ok := requiredPolicyString(at, resource, "service", report)
+	if !ok || service == "*" {
+		return "", false
+	}
+	if !slices.Contains(managementServices, service) {
+		report(at+".service", "%q is not a service; expected * or one of %s", service, strings.Join(managementServices, ", "))
+		return "", false
+	}
+	return service, true
+}
+
+func validatePolicyCondition(at string, value any, report func(at, format string, args ...any)) {
+	condition, ok := value.(map[string]any)
+	if !ok {
+		report(at, "expected an object, got %s", jsonKind(value))
+		return
+	}
+	requiredPolicyString(at, condition, "operator", report)
+	requiredPolicyString(at, condition, "key", report)
+	values, _ := policyArray(at, condition, "values", report)
+	for i, v := range values {
+		if _, isString := v.(string); !isString {
+			report(fmt.Sprintf("%s.values[%d]", at, i), "expected a string, got %s", jsonKind(v))
+		}
+	}
+}
+
+// requiredPolicyString returns object[key] when it is a non-empty string, reporting otherwise.
+func requiredPolicyString(at string, object map[string]any, key string, report func(at, format string, args ...any)) (string, bool) {
+	value, ok := object[key]
+	if !ok {
+		report(at, "missing required key %q", key)
+		return "", false
+	}
+	s, ok := value.(string)
+	if !ok {
+		report(at+"."+key, "expected a string, got %s", jsonKind(value))
+		return "", false
+	}
+	if strings.TrimSpace(s) == "" {
+		report(at+"."+key, "must not be empty")
+		return "", false
+	}
+	return s, true
+}
+
+// policyArray returns object[key] when it is an array, reporting otherwise.
+func policyArray(at string, object map[string]any, key string, report func(at, format string, args ...any)) ([]any, bool) {
+	value, ok := object[key]
+	if !ok {
+		report(at, "missing required key %q", key)
+		return nil, false
+	}
+	list, ok := value.([]any)
+	if !ok {
+		report(at+"."+key, "expected an array, got %s", jsonKind(value))
+		return nil, false
+	}
+	return list, true
+}
+
+func jsonKind(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "a boolean"
+	case float64:
+		return "a number"
+	case string:
+		return "a string"
+	case []any:
+		return "an array"
+	default:
+		return "an object"
+	}
+}
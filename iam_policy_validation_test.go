@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func validatePolicyJSON(t *testing.T, raw string) (problems, warnings []string) {
+	t.Helper()
+	var doc any
+	if err := json.Unmarshal([]byte(raw), &doc); err != nil {
+		t.Fatalf("invalid test JSON: %s", err)
+	}
+	return validatePolicyDocument(doc)
+}
+
+func TestValidatePolicyDocument_AcceptsValidDocuments(t *testing.T) {
+	for _, raw := range []string{
+		`{"version":"1.0","permissions":[]}`,
+		`{"version":"1.0","permissions":[{"effect":"allow","scope":"management","actions":["read","list"],"resources":[{"region":"global","service":"iam","resourceType":"*","resourceId":"*"}]}]}`,
+		`{"permissions":[{"sid":"no-deletes","effect":"deny","scope":"management","actions":["delete"],"resources":[{"region":"eu","service":"gateway","resourceType":"instance","resourceId":"tf-0a1b2c"}],"conditions":[{"operator":"StringEquals","key":"region","values":["eu"]}]}]}`,
+		`{"permissions":[{"effect":"allow","scope":"management","actions":["*"],"resources":[{"region":"*","service":"*","resourceType":"*","resourceId":"*"}]}]}`,
+		// Action names are only known for the management scope; unknown keys are ignored.
+		`{"permissions":[{"effect":"allow","scope":"service","actions":["invoke"],"resources":[{"region":"eu","service":"authorizer","resourceType":"instance","resourceId":"*"}],"extra":true}]}`,
+	} {
+		if problems, warnings := validatePolicyJSON(t, raw); len(problems) != 0 || len(warnings) != 0 {
+			t.Fatalf("expected %s to be valid, got %v %v", raw, problems, warnings)
+		}
+	}
+}
+
+func TestValidatePolicyDocument_ReportsJSONPaths(t *testing.T) {
+	cases := []struct {
+		raw  string
+		want string
+	}{
+		{`[]`, `$: expected a JSON object, got an array`},
+		{`{"version":1,"permissions":[]}`, `$.version: expected a version string like "1.0"`},
+		{`{"version":"1.0"}`, `$: missing required key "permissions"`},
+		{`{"permissions":{}}`, `$.permissions: expected an array, got an object`},
+		{`{"permissions":["read"]}`, `$.permissions[0]: expected an object, got a string`},
+		{
+			`{"permissions":[{"effect":"permit","scope":"management","actions":["read"],"resources":[]}]}`,
+			`$.permissions[0].effect: "permit" is not supported; expected allow or deny`,
+		},
+		{
+			`{"permissions":[{"effect":"allow","actions":["read"],"resources":[]}]}`,
+			`$.permissions[0]: missing required key "scope"`,
+		},
+		{
+			`{"permissions":[{"effect":"allow","scope":"management","actions":[],"resources":[]}]}`,
+			`$.permissions[0].actions: at least one action is required`,
+		},
+		{
+			`{"permissions":[{"effect":"allow","scope":"management","actions":["read"],"resources":[{"region":"global","service":"billing","resourceType":"*","resourceId":"*"}]}]}`,
+			`$.permissions[0].resources[0].service: "billing" is not a service; expected * or one of iam, resolver, verifier, validator, authorizer, gateway`,
+		},
+		{
+			`{"permissions":[{"effect":"allow","scope":"management","actions":["read"],"resources":[{"region":"EU","service":"iam","resourceType":"*","resourceId":"*"}]}]}`,
+			`$.permissions[0].resources[0].region: "EU" is not a region; expected *, global or a region slug such as eu`,
+		},
+		{
+			`{"permissions":[{"effect":"allow","scope":"management","actions":["read"],"resources":[{"region":"eu","service":"gateway","resourceType":"instance","resourceId":"a/b"}]}]}`,
+			`$.permissions[0].resources[0].resourceId: "a/b" is not a resource ID; expected * or letters, digits, '-', '_' and '.'`,
+		},
+		{
+			`{"permissions":[{"effect":"allow","scope":"management","actions":["read"],"resources":[{"region":"eu","service":"gateway","resourceId":"*"}]}]}`,
+			`$.permissions[0].resources[0]: missing required key "resourceType"`,
+		},
+		{
+			`{"permissions":[{"effect":"allow","scope":"management","actions":["read"],"resources":[],"conditions":[{"operator":"StringEquals","key":"region","values":[1]}]}]}`,
+			`$.permissions[0].conditions[0].values[0]: expected a string, got a number`,
+		},
+	}
+	for _, tc := range cases {
+		problems, _ := validatePolicyJSON(t, tc.raw)
+		if len(problems) != 1 || problems[0] != tc.want {
+			t.Fatalf("document %s:\n got: %v\nwant: [%s]", tc.raw, problems, tc.want)
+		}
+	}
+}
+
+func TestValidatePolicyDocument_UnknownActionIsAWarning(t *testing.T) {
+	problems, warnings := validatePolicyJSON(t, `{"permissions":[{"effect":"allow","scope":"management","actions":["read","reed"],"resources":[{"region":"global","service":"gateway","resourceType":"*","resourceId":"*"}]}]}`)
+	want := `$.permissions[0].actions[1]: "reed" is not a known action of service gateway; expected one of create, read, update, delete, list or *`
+	if len(problems) != 0 || len(warnings) != 1 || warnings[0] != want {
+		t.Fatalf("expected one warning %q, got %v %v", want, problems, warnings)
+	}
+}
+
+func TestValidatePolicyDocument_AcceptsActionsTheProviderCalls(t *testing.T) {
+	// rotate: vidos_iam_api_key rotation; assume: provider assume_role; whoami: caller identity.
+	for _, action := range []string{"rotate", "assume", "whoami"} {
+		raw := `{"permissions":[{"effect":"allow","scope":"management","actions":["` + action + `"],"resources":[{"region":"global","service":"iam","resourceType":"*","resourceId":"*"}]}]}`
+		if problems, warnings := validatePolicyJSON(t, raw); len(problems) != 0 || len(warnings) != 0 {
+			t.Fatalf("%s: expected no diagnostics, got %v %v", action, problems, warnings)
+		}
+	}
+}
+
+func TestPolicyDocumentValidator_ValidateString(t *testing.T) {
+	ctx := context.Background()
+	v := policyDocumentValidator{}
+	validate := func(value types.String) diag.Diagnostics {
+		resp := &validator.StringResponse{}
+		v.ValidateString(ctx, validator.StringRequest{Path: path.Root("document"), ConfigValue: value}, resp)
+		return resp.Diagnostics
+	}
+
+	// Null, unknown and malformed values are left to other checks.
+	for _, value := range []types.String{types.StringNull(), types.StringUnknown(), types.StringValue(`{"permissions":`)} {
+		if diags := validate(value); diags.HasError() {
+			t.Fatalf("unexpected diagnostics for %s: %#v", value, diags)
+		}
+	}
+
+	diags := validate(types.StringValue(`{"permissions":[{"effect":"permit","scope":"management","actions":["read"],"resources":[]},{"scope":"management","actions":["read"],"resources":[]}]}`))
+	if len(diags.Errors()) != 2 {
+		t.Fatalf("expected one error per problem, got %#v", diags)
+	}
+	for _, d := range diags.Errors() {
+		withPath, ok := d.(diag.DiagnosticWithPath)
+		if !ok || !withPath.Path().Equal(path.Root("document")) {
+			t.Fatalf("expected error on document, got %#v", d)
+		}
+	}
+	if !strings.HasPrefix(diags.Errors()[1].Detail(), "$.permissions[1]: ") {
+		t.Fatalf("expected JSON path in detail, got %q", diags.Errors()[1].Detail())
+	}
+
+	diags = validate(types.StringValue(`{"permissions":[{"effect":"allow","scope":"management","actions":["export"],"resources":[{"region":"global","service":"iam","resourceType":"*","resourceId":"*"}]}]}`))
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("expected an unknown action to only warn, got %#v", diags)
+	}
+}
+
+func TestPolicyDocumentValidator_AcceptsRenderedDocuments(t *testing.T) {
+	rendered, diags := renderPolicyDocument(iamPolicyDocumentDataSourceModel{
+		Statements: []iamPolicyStatementModel{{
+			Actions:   stringList("read", "list"),
+			Resources: []iamPolicyStatementResourceModel{{Service: types.StringValue("iam")}},
+		}},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", diags)
+	}
+	if problems, _ := validatePolicyJSON(t, rendered); len(problems) != 0 {
+		t.Fatalf("expected rendered document to be valid, got %v", problems)
+	}
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*jsonStringType)(nil)
+var _ basetypes.StringValuableWithSemanticEquals = (*jsonString)(nil)
+var _ xattr.ValidateableAttribute = (*jsonString)(nil)
+
+// jsonStringType is a string type holding a JSON document. Values compare semantically so
+// that the server's re-encoding of a document (key order, whitespace, added defaults) does
+// not surface as a diff.
+type jsonStringType struct {
+	basetypes.StringType
+}
+
+func (t jsonStringType) Equal(o attr.Type) bool {
+	other, ok := o.(jsonStringType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t jsonStringType) String() string {
+	return "jsonStringType"
+}
+
+func (t jsonStringType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return jsonString{StringValue: in}, nil
+}
+
+func (t jsonStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
+
+func (t jsonStringType) ValueType(_ context.Context) attr.Value {
+	return jsonString{}
+}
+
+type jsonString struct {
+	basetypes.StringValue
+}
+
+func jsonStringNull() jsonString {
+	return jsonString{StringValue: basetypes.NewStringNull()}
+}
+
+func jsonStringUnknown() jsonString {
+	return jsonString{StringValue: basetypes.NewStringUnknown()}
+}
+
+func jsonStringValue(value string) jsonString {
+	return jsonString{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v jsonString) Type(_ context.Context) attr.Type {
+	return jsonStringType{}
+}
+
+func (v jsonString) Equal(o attr.Value) bool {
+	other, ok := o.(jsonString)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals is called on the value returned by the provider with the prior
+// (planned or previously stored) value. Returning true keeps the prior value.
+func (v jsonString) StringSemanticEquals(_ context.Context, priorValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	prior, ok := priorValuable.(jsonString)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this to the provider developers.", v, priorValuable),
+		)
+		return false, diags
+	}
+
+	return jsonSemanticallyContains(prior.ValueString(), v.ValueString()), diags
+}
+
+func (v jsonString) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+	trimmed := strings.TrimSpace(v.ValueString())
+	if trimmed == "" {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid JSON", "value must be valid JSON")
+		return
+	}
+	var out any
+	if err := json.Unmarshal([]byte(trimmed), &out); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid JSON", "value must be valid JSON: "+err.Error())
+	}
+}
+
+// jsonSemanticallyContains reports whether the document got carries everything in want.
+// Object keys only present in got are ignored so server-populated defaults don't count as
+// drift; arrays must match element-wise.
+func jsonSemanticallyContains(want, got string) bool {
+	if want == got {
+		return true
+	}
+	var w, g any
+	if err := json.Unmarshal([]byte(want), &w); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(got), &g); err != nil {
+		return false
+	}
+	return jsonValueContains(w, g)
+}
+
+func jsonValueContains(want, got any) bool {
+	switch w := want.(type) {
+	case map[string]any:
+		g, ok := got.(map[string]any)
+		if !ok {
+			return false
+		}
+		for k, wv := range w {
+			gv, ok := g[k]
+			if !ok {
+				// An explicit null is equivalent to the server omitting the key.
+				if wv == nil {
+					continue
+				}
+				return false
+			}
+			if !jsonValueContains(wv, gv) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		g, ok := got.([]any)
+		if !ok || len(g) != len(w) {
+			return false
+		}
+		for i := range w {
+			if !jsonValueContains(w[i], g[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(want, got)
+	}
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestJSONStringType_EqualAndString(t *testing.T) {
+	if !(jsonStringType{}).Equal(jsonStringType{}) {
+		t.Fatalf("expected equal types")
+	}
+	if (jsonStringType{}).Equal(types.StringType) {
+		t.Fatalf("expected plain string type to differ")
+	}
+	if got := (jsonStringType{}).String(); got != "jsonStringType" {
+		t.Fatalf("unexpected type string: %q", got)
+	}
+	if _, ok := (jsonStringType{}).ValueType(context.Background()).(jsonString); !ok {
+		t.Fatalf("expected jsonString value type")
+	}
+}
+
+func TestJSONStringType_ValueFromTerraform(t *testing.T) {
+	ctx := context.Background()
+
+	v, err := jsonStringType{}.ValueFromTerraform(ctx, tftypes.NewValue(tftypes.String, `{"a":1}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	js, ok := v.(jsonString)
+	if !ok {
+		t.Fatalf("expected jsonString, got %T", v)
+	}
+	if js.ValueString() != `{"a":1}` {
+		t.Fatalf("unexpected value: %q", js.ValueString())
+	}
+	if !js.Type(ctx).Equal(jsonStringType{}) {
+		t.Fatalf("unexpected value type")
+	}
+
+	v, err = jsonStringType{}.ValueFromTerraform(ctx, tftypes.NewValue(tftypes.String, nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !v.IsNull() {
+		t.Fatalf("expected null value")
+	}
+
+	if _, err := (jsonStringType{}).ValueFromTerraform(ctx, tftypes.NewValue(tftypes.Number, 1)); err == nil {
+		t.Fatalf("expected error for non-string value")
+	}
+}
+
+func TestJSONString_Equal(t *testing.T) {
+	if !jsonStringValue(`{"a":1}`).Equal(jsonStringValue(`{"a":1}`)) {
+		t.Fatalf("expected equal values")
+	}
+	if jsonStringValue(`{"a":1}`).Equal(jsonStringValue(`{ "a": 1 }`)) {
+		t.Fatalf("expected Equal to be exact, not semantic")
+	}
+	if jsonStringValue(`{"a":1}`).Equal(types.StringValue(`{"a":1}`)) {
+		t.Fatalf("expected plain string value to differ")
+	}
+	if !jsonStringNull().IsNull() || !jsonStringUnknown().IsUnknown() {
+		t.Fatalf("unexpected null/unknown constructors")
+	}
+}
+
+func TestJSONString_StringSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		prior string
+		got   string
+		want  bool
+	}{
+		{"identical", `{"a":1}`, `{"a":1}`, true},
+		{"whitespace", "{\n  \"a\": 1\n}", `{"a":1}`, true},
+		{"key order", `{"a":1,"b":{"c":[1,2]}}`, `{"b":{"c":[1,2]},"a":1}`, true},
+		{"number formatting", `{"a":1.0}`, `{"a":1}`, true},
+		{"server default added", `{"cors":{"enabled":true}}`, `{"cors":{"enabled":true,"origin":[]},"paths":{}}`, true},
+		{"explicit null omitted by server", `{"a":1,"b":null}`, `{"a":1}`, true},
+		{"value changed", `{"a":1}`, `{"a":2}`, false},
+		{"key removed by server", `{"a":1,"b":2}`, `{"a":1}`, false},
+		{"array reordered", `[1,2]`, `[2,1]`, false},
+		{"array extra element", `[1]`, `[1,2]`, false},
+		{"type changed", `{"a":{}}`, `{"a":[]}`, false},
+		{"array vs object", `[]`, `{}`, false},
+		{"invalid prior", `{bad`, `{}`, false},
+		{"invalid new", `{}`, `{bad`, false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, diags := jsonStringValue(tc.got).StringSemanticEquals(context.Background(), jsonStringValue(tc.prior))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %#v", diags)
+			}
+			if got != tc.want {
+				t.Fatalf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestJSONString_StringSemanticEquals_TypeMismatch(t *testing.T) {
+	_, diags := jsonStringValue(`{}`).StringSemanticEquals(context.Background(), types.StringValue(`{}`))
+	if !diags.HasError() {
+		t.Fatalf("expected error diagnostics")
+	}
+}
+
+func TestJSONString_ValidateAttribute(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   jsonString
+		wantErr bool
+	}{
+		{"null", jsonStringNull(), false},
+		{"unknown", jsonStringUnknown(), false},
+		{"valid", jsonStringValue(`{"a":[1,2]}`), false},
+		{"empty", jsonStringValue("  "), true},
+		{"invalid", jsonStringValue(`{bad}`), true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var resp xattr.ValidateAttributeResponse
+			tc.value.ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{Path: path.Root("document")}, &resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error=%v, got %#v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
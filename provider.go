@@ -1,12 +1,20 @@
 package main
 
 import (
+	"cmp"
 	"context"
+	"fmt"
+	"math"
+	"net/http"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -21,14 +29,94 @@ type VidosProvider struct {
 }
 
 type providerModel struct {
-	Region types.String `tfsdk:"region"`
-	ApiKey types.String `tfsdk:"api_key"`
+	Region    types.String            `tfsdk:"region"`
+	ApiKey    types.String            `tfsdk:"api_key"`
+	Domain    types.String            `tfsdk:"domain"`
+	Endpoints *providerEndpointsModel `tfsdk:"endpoints"`
+
+	Profile               types.String `tfsdk:"profile"`
+	SharedCredentialsFile types.String `tfsdk:"shared_credentials_file"`
+	CredentialProcess     types.String `tfsdk:"credential_process"`
+
+	AssumeRole *providerAssumeRoleModel `tfsdk:"assume_role"`
+
+	CABundle              types.String `tfsdk:"ca_bundle"`
+	CABundleFile          types.String `tfsdk:"ca_bundle_file"`
+	ClientCertificateFile types.String `tfsdk:"client_certificate_file"`
+	ClientKeyFile         types.String `tfsdk:"client_key_file"`
+	ProxyURL              types.String `tfsdk:"proxy_url"`
+	Insecure              types.Bool   `tfsdk:"insecure"`
+
+	MaxRetries           types.Int64  `tfsdk:"max_retries"`
+	RetryMinBackoff      types.String `tfsdk:"retry_min_backoff"`
+	RetryMaxBackoff      types.String `tfsdk:"retry_max_backoff"`
+	RetryableStatusCodes types.Set    `tfsdk:"retryable_status_codes"`
+
+	MaxRequestsPerSecond  types.Float64 `tfsdk:"max_requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+
+	ApiVersion          types.String `tfsdk:"api_version"`
+	SkipAPIVersionCheck types.Bool   `tfsdk:"skip_api_version_check"`
+
+	SkipCredentialsValidation types.Bool `tfsdk:"skip_credentials_validation"`
+}
+
+// providerAssumeRoleModel selects a service role whose credentials the provider uses.
+type providerAssumeRoleModel struct {
+	ServiceRoleID types.String `tfsdk:"service_role_id"`
+	Owner         types.String `tfsdk:"owner"`
+}
+
+// providerEndpointsModel overrides the base URL of individual management services.
+type providerEndpointsModel struct {
+	Iam        types.String `tfsdk:"iam"`
+	Resolver   types.String `tfsdk:"resolver"`
+	Verifier   types.String `tfsdk:"verifier"`
+	Validator  types.String `tfsdk:"validator"`
+	Authorizer types.String `tfsdk:"authorizer"`
+	Gateway    types.String `tfsdk:"gateway"`
+}
+
+// managementServices are the services with a management API, in endpoints block order.
+var managementServices = []string{"iam", "resolver", "verifier", "validator", "authorizer", "gateway"}
+
+// byService returns the configured endpoint per service; a missing block yields nulls.
+func (m *providerEndpointsModel) byService() map[string]types.String {
+	if m == nil {
+		m = &providerEndpointsModel{}
+	}
+	return map[string]types.String{
+		"iam":        m.Iam,
+		"resolver":   m.Resolver,
+		"verifier":   m.Verifier,
+		"validator":  m.Validator,
+		"authorizer": m.Authorizer,
+		"gateway":    m.Gateway,
+	}
 }
 
 type providerConfig struct {
 	domain        string
 	defaultRegion string
 	apiKeySecret  string
+	// credentialProcess is the command producing the API key; when set, apiKeySecret is empty.
+	credentialProcess string
+	// assumeRole, when set, exchanges the API key for service role credentials.
+	assumeRole *assumeRoleConfig
+	// httpTransport carries the TLS and proxy settings; nil means http.DefaultTransport.
+	httpTransport *http.Transport
+	retry         retryPolicy
+	// maxRequestsPerSecond and maxConcurrentRequests limit requests per service host;
+	// zero means unlimited.
+	maxRequestsPerSecond  float64
+	maxConcurrentRequests int
+	// apiVersion is sent as X-Vidos-Api-Version; empty means defaultAPIVersion.
+	apiVersion          string
+	skipAPIVersionCheck bool
+	// skipCredentialsValidation disables the caller identity lookup in Configure.
+	skipCredentialsValidation bool
+	// endpoints maps a service name to an overriding base URL.
+	endpoints map[string]string
 }
 
 func New() provider.Provider {
@@ -53,10 +141,127 @@ func (p *VidosProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				Sensitive:   true,
 				Description: "Vidos IAM API secret (64 hex) used as Authorization: Bearer <api_key>.",
 			},
+			"domain": schema.StringAttribute{
+				Optional:    true,
+				Description: "Base domain of the management endpoints (https://<service>.management.<region>.<domain>). Defaults to VIDOS_DOMAIN, then " + defaultDomain + ".",
+				Validators:  []validator.String{domainValidator{}},
+			},
+			"profile": schema.StringAttribute{
+				Optional: true,
+				Description: "Named profile in the shared credentials file supplying api_key, region, domain and endpoint overrides. Defaults to VIDOS_PROFILE, then default. " +
+					"When set here, its credentials, region, domain and endpoints take precedence over VIDOS_API_KEY, VIDOS_CREDENTIAL_PROCESS, VIDOS_REGION, VIDOS_DOMAIN and VIDOS_<SERVICE>_ENDPOINT.",
+			},
+			"shared_credentials_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of the shared credentials file. Defaults to VIDOS_SHARED_CREDENTIALS_FILE, then ~/.vidos/credentials.",
+			},
+			"ca_bundle": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM encoded CA certificates trusted in addition to the system roots, e.g. for a TLS-inspecting proxy. Conflicts with ca_bundle_file.",
+			},
+			"ca_bundle_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a PEM file with CA certificates trusted in addition to the system roots. Defaults to VIDOS_CA_BUNDLE.",
+			},
+			"client_certificate_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a PEM client certificate for mutual TLS. Requires client_key_file. Defaults to VIDOS_CLIENT_CERTIFICATE_FILE.",
+			},
+			"client_key_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of the PEM private key of client_certificate_file. Defaults to VIDOS_CLIENT_KEY_FILE.",
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "Proxy for all API requests, used instead of HTTPS_PROXY/HTTP_PROXY. NO_PROXY still applies. Defaults to VIDOS_PROXY_URL.",
+			},
+			"insecure": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip TLS certificate verification, e.g. for a self-hosted stack with self-signed certificates. Never applies to hosts under " + defaultDomain + ". Defaults to VIDOS_INSECURE.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum retries of a throttled or failed request; retries also stop when the operation times out. Defaults to VIDOS_MAX_RETRIES, then 4.",
+				Validators:  []validator.Int64{int64AtLeastValidator{min: 0}},
+			},
+			"retry_min_backoff": schema.StringAttribute{
+				Optional:    true,
+				Description: "Backoff before the first retry, doubled on each further retry (e.g. 500ms). Defaults to VIDOS_RETRY_MIN_BACKOFF, then 250ms.",
+				Validators:  []validator.String{durationValidator{}},
+			},
+			"retry_max_backoff": schema.StringAttribute{
+				Optional:    true,
+				Description: "Upper bound of the backoff between retries (e.g. 30s). A Retry-After header from the API takes precedence. Defaults to VIDOS_RETRY_MAX_BACKOFF, then 5s.",
+				Validators:  []validator.String{durationValidator{}},
+			},
+			"retryable_status_codes": schema.SetAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: "Additional HTTP status codes retried for idempotent requests (GET, PUT, DELETE), e.g. [500]. 429, 502, 503 and 504 are always retried.",
+				Validators:  []validator.Set{statusCodeSetValidator{}},
+			},
+			"max_requests_per_second": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Maximum request rate per service host, shared by all operations Terraform runs in parallel (e.g. 5, or 0.5); must be greater than 0. Unlimited by default. Defaults to VIDOS_MAX_REQUESTS_PER_SECOND.",
+				Validators:  []validator.Float64{float64PositiveValidator{}},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum requests in flight per service host; must be at least 1. Unlimited by default. Defaults to VIDOS_MAX_CONCURRENT_REQUESTS.",
+				Validators:  []validator.Int64{int64AtLeastValidator{min: 1}},
+			},
+			"api_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Management API version sent with every request (e.g. 1.1). Checked against the versions the server supports when the provider is configured. Defaults to VIDOS_API_VERSION, then " + defaultAPIVersion + ".",
+			},
+			"skip_api_version_check": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip asking the server which API versions it supports, e.g. for mocks without the endpoint. Defaults to VIDOS_SKIP_API_VERSION_CHECK.",
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip looking up the caller identity when the provider is configured. Invalid credentials then only surface on the first API call. Defaults to VIDOS_SKIP_CREDENTIALS_VALIDATION.",
+			},
+			"credential_process": schema.StringAttribute{
+				Optional:    true,
+				Description: "Command printing a JSON document with api_key and an optional RFC3339 expiration, used instead of api_key. It is run again when the key expires or is rejected. Defaults to VIDOS_CREDENTIAL_PROCESS.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"endpoints": endpointsSchemaBlock(),
+			"assume_role": schema.SingleNestedBlock{
+				Description: "Service role to assume. The API key is exchanged for the role's scoped credentials, which are used for every request.",
+				Attributes: map[string]schema.Attribute{
+					"service_role_id": schema.StringAttribute{
+						Optional:    true,
+						Description: "Resource ID of the service role. Required when the block is present.",
+					},
+					"owner": schema.StringAttribute{
+						Optional:    true,
+						Description: "Service role owner: account (default) or managed.",
+						Validators:  []validator.String{stringOneOfValidator{values: settingsServiceRoleOwners}},
+					},
+				},
+			},
 		},
 	}
 }
 
+func endpointsSchemaBlock() schema.SingleNestedBlock {
+	attrs := map[string]schema.Attribute{}
+	for _, service := range managementServices {
+		attrs[service] = schema.StringAttribute{
+			Optional:    true,
+			Description: "Base URL of the " + service + " management API, used instead of the URL derived from domain and region. Defaults to VIDOS_" + strings.ToUpper(service) + "_ENDPOINT.",
+			Validators:  []validator.String{endpointValidator{}},
+		}
+	}
+	return schema.SingleNestedBlock{
+		Description: "Per-service endpoint overrides, e.g. for staging stacks or local mocks.",
+		Attributes:  attrs,
+	}
+}
+
 func (p *VidosProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var config providerModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -71,41 +276,397 @@ func (p *VidosProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	}
 
 	client := NewAPIClient(cfg)
+	if client.credentials != nil {
+		// Fetch credentials now so a broken command or role fails the run up front rather
+		// than on the first API call.
+		if _, err := client.credentials.get(ctx); err != nil {
+			resp.Diagnostics.AddError("Failed to obtain credentials", err.Error())
+			return
+		}
+	}
+	logFields := map[string]any{
+		"region":    cfg.defaultRegion,
+		"domain":    cfg.domain,
+		"endpoints": cfg.endpoints,
+	}
+	if !cfg.skipCredentialsValidation {
+		// A bad key would otherwise only surface when the first resource operation fails,
+		// often deep into an apply.
+		identity, diags := client.callerIdentity(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		logFields["account_id"] = identity.AccountID
+		logFields["api_key_id"] = identity.ApiKeyID
+	}
+	if !cfg.skipAPIVersionCheck {
+		resp.Diagnostics.Append(client.checkAPIVersion(ctx)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	resp.ResourceData = client
 	resp.DataSourceData = client
+	resp.EphemeralResourceData = client
 
-	tflog.Info(ctx, "Configured Vidos provider", map[string]any{
-		"region": cfg.defaultRegion,
-	})
+	tflog.Info(ctx, "Configured Vidos provider", logFields)
 }
 
+// buildProviderConfig resolves every setting from, in order: the provider attribute, its
+// environment variable, the selected shared credentials profile, then the built-in default.
 func buildProviderConfig(config providerModel) (providerConfig, diag.Diagnostics) {
-	var diags diag.Diagnostics
+	profile, diags := resolveCredentialsProfile(config)
+	if diags.HasError() {
+		return providerConfig{}, diags
+	}
+
+	// The build-time default (ldflags) applies unless the domain is configured.
+	domain := firstNonEmpty(getFirstNonEmpty(config.Domain, profileScopedEnv(config, "VIDOS_DOMAIN")), profile.domain, defaultDomain)
+	if err := validateDomain(domain); err != nil {
+		diags.AddAttributeError(path.Root("domain"), "Invalid domain", err.Error())
+	}
+
+	endpoints := map[string]string{}
+	for service, value := range config.Endpoints.byService() {
+		endpoint := firstNonEmpty(getFirstNonEmpty(value, profileScopedEnv(config, "VIDOS_"+strings.ToUpper(service)+"_ENDPOINT")), profile.endpoints[service])
+		if endpoint == "" {
+			continue
+		}
+		if err := validateEndpointURL(endpoint); err != nil {
+			diags.AddAttributeError(path.Root("endpoints").AtName(service), "Invalid endpoint", err.Error())
+			continue
+		}
+		endpoints[service] = strings.TrimRight(endpoint, "/")
+	}
+	if diags.HasError() {
+		return providerConfig{}, diags
+	}
+
+	defaultRegion := firstNonEmpty(getFirstNonEmpty(config.Region, profileScopedEnv(config, "VIDOS_REGION")), profile.region, "eu")
+	if !regionSlugRegex.MatchString(defaultRegion) {
+		diags.AddAttributeError(path.Root("region"), "Invalid region", fmt.Sprintf("%q must be lowercase and contain only letters, digits, and hyphens", defaultRegion))
+		return providerConfig{}, diags
+	}
 
-	// Domain is set at build time via ldflags
-	domain := defaultDomain
+	apiKey, credentialProcess, credDiags := resolveCredentials(config, profile)
+	diags.Append(credDiags...)
+	if diags.HasError() {
+		return providerConfig{}, diags
+	}
+
+	httpTransport, transportDiags := resolveHTTPTransport(config, domain, endpoints)
+	diags.Append(transportDiags...)
+	if diags.HasError() {
+		return providerConfig{}, diags
+	}
 
-	defaultRegion := getFirstNonEmpty(config.Region, os.Getenv("VIDOS_REGION"))
-	if defaultRegion == "" {
-		defaultRegion = "eu"
+	retry, retryDiags := resolveRetryPolicy(config)
+	diags.Append(retryDiags...)
+	if diags.HasError() {
+		return providerConfig{}, diags
 	}
 
-	apiKey := getFirstNonEmpty(config.ApiKey, os.Getenv("VIDOS_API_KEY"))
-	if apiKey == "" {
-		diags.AddError(
-			"Missing API key",
-			"Set provider attribute api_key or env var VIDOS_API_KEY.",
-		)
+	maxRequestsPerSecond, maxConcurrentRequests, limitDiags := resolveRequestLimits(config)
+	diags.Append(limitDiags...)
+	if diags.HasError() {
 		return providerConfig{}, diags
 	}
 
+	apiVersion := defaultAPIVersion
+	if raw := getFirstNonEmpty(config.ApiVersion, os.Getenv("VIDOS_API_VERSION")); raw != "" {
+		v, err := parseAPIVersion(raw)
+		if err != nil {
+			diags.AddAttributeError(path.Root("api_version"), "Invalid API version", err.Error())
+			return providerConfig{}, diags
+		}
+		apiVersion = v.String()
+	}
+	skipAPIVersionCheck := getBool(&diags, config.SkipAPIVersionCheck, "VIDOS_SKIP_API_VERSION_CHECK", path.Root("skip_api_version_check"))
+	skipCredentialsValidation := getBool(&diags, config.SkipCredentialsValidation, "VIDOS_SKIP_CREDENTIALS_VALIDATION", path.Root("skip_credentials_validation"))
+
+	var assumeRole *assumeRoleConfig
+	if config.AssumeRole != nil {
+		serviceRoleID, ok := requireKnownString(&diags, config.AssumeRole.ServiceRoleID, path.Root("assume_role").AtName("service_role_id"), "service_role_id")
+		if !ok {
+			return providerConfig{}, diags
+		}
+		assumeRole = &assumeRoleConfig{
+			serviceRoleID: serviceRoleID,
+			owner:         firstNonEmpty(getFirstNonEmpty(config.AssumeRole.Owner, ""), "account"),
+		}
+	}
+
 	return providerConfig{
-		domain:        domain,
-		defaultRegion: defaultRegion,
-		apiKeySecret:  apiKey,
+		domain:            domain,
+		defaultRegion:     defaultRegion,
+		apiKeySecret:      apiKey,
+		credentialProcess: credentialProcess,
+		assumeRole:        assumeRole,
+		httpTransport:     httpTransport,
+		retry:             retry,
+
+		maxRequestsPerSecond:  maxRequestsPerSecond,
+		maxConcurrentRequests: maxConcurrentRequests,
+
+		apiVersion:          apiVersion,
+		skipAPIVersionCheck: skipAPIVersionCheck,
+
+		skipCredentialsValidation: skipCredentialsValidation,
+		endpoints:                 endpoints,
 	}, diags
 }
 
+// resolveCredentials picks either a static API key or a credential process. The attributes,
+// then the environment, then the profile are consulted; the first of those to set either one
+// decides, and setting both at the same level is ambiguous. A profile named in the provider
+// configuration is skipped past the environment, so an exported key can't silently replace it.
+func resolveCredentials(config providerModel, profile credentialsProfile) (apiKey, credentialProcess string, diags diag.Diagnostics) {
+	sources := []struct {
+		name            string
+		apiKey, process string
+	}{
+		{"provider configuration", getFirstNonEmpty(config.ApiKey, ""), getFirstNonEmpty(config.CredentialProcess, "")},
+		{"environment", strings.TrimSpace(os.Getenv("VIDOS_API_KEY")), strings.TrimSpace(os.Getenv("VIDOS_CREDENTIAL_PROCESS"))},
+		{"shared credentials profile", profile.apiKey, profile.credentialProcess},
+	}
+	if profileSetInConfig(config) {
+		sources = append(sources[:1], sources[2:]...)
+	}
+	for _, src := range sources {
+		if src.apiKey == "" && src.process == "" {
+			continue
+		}
+		if src.apiKey != "" && src.process != "" {
+			diags.AddError("Conflicting credentials", fmt.Sprintf("The %s sets both an API key and a credential process; set only one.", src.name))
+			return "", "", diags
+		}
+		return src.apiKey, src.process, diags
+	}
+
+	diags.AddError(
+		"Missing API key",
+		"Set provider attribute api_key or credential_process, env var VIDOS_API_KEY or VIDOS_CREDENTIAL_PROCESS, or api_key or credential_process in a shared credentials profile.",
+	)
+	return "", "", diags
+}
+
+// profileSetInConfig reports whether the profile attribute is set. An explicitly chosen profile
+// outranks the environment for credentials, region, domain and endpoints, so a stray variable
+// can't send the profile's credentials to another environment's hosts.
+func profileSetInConfig(config providerModel) bool {
+	return getFirstNonEmpty(config.Profile, "") != ""
+}
+
+// profileScopedEnv returns the environment variable name, or "" when the profile attribute is
+// set and the profile's value applies instead.
+func profileScopedEnv(config providerModel, name string) string {
+	if profileSetInConfig(config) {
+		return ""
+	}
+	return os.Getenv(name)
+}
+
+// resolveRetryPolicy reads the retry settings; unset settings keep their defaults.
+func resolveRetryPolicy(config providerModel) (retryPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var policy retryPolicy
+
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		n := int(config.MaxRetries.ValueInt64())
+		policy.maxRetries = &n
+	} else if env := strings.TrimSpace(os.Getenv("VIDOS_MAX_RETRIES")); env != "" {
+		n, err := strconv.Atoi(env)
+		if err != nil || n < 0 {
+			diags.AddAttributeError(path.Root("max_retries"), "Invalid VIDOS_MAX_RETRIES", fmt.Sprintf("%q is not a non-negative integer", env))
+		}
+		policy.maxRetries = &n
+	}
+
+	backoff := func(attr types.String, env string, p path.Path) time.Duration {
+		raw := getFirstNonEmpty(attr, os.Getenv(env))
+		if raw == "" {
+			return 0
+		}
+		d, err := parseDuration(raw)
+		if err == nil && d <= 0 {
+			err = fmt.Errorf("invalid duration %q: must be positive", raw)
+		}
+		if err != nil {
+			diags.AddAttributeError(p, "Invalid duration", err.Error())
+		}
+		return d
+	}
+	policy.minBackoff = backoff(config.RetryMinBackoff, "VIDOS_RETRY_MIN_BACKOFF", path.Root("retry_min_backoff"))
+	policy.maxBackoff = backoff(config.RetryMaxBackoff, "VIDOS_RETRY_MAX_BACKOFF", path.Root("retry_max_backoff"))
+	if diags.HasError() {
+		return retryPolicy{}, diags
+	}
+	if minBackoff, maxBackoff := cmp.Or(policy.minBackoff, defaultRetryMinBackoff), cmp.Or(policy.maxBackoff, defaultRetryMaxBackoff); minBackoff > maxBackoff {
+		diags.AddAttributeError(path.Root("retry_max_backoff"), "Invalid retry backoff", fmt.Sprintf("retry_max_backoff (%s) must not be shorter than retry_min_backoff (%s).", maxBackoff, minBackoff))
+		return retryPolicy{}, diags
+	}
+
+	if !config.RetryableStatusCodes.IsNull() && !config.RetryableStatusCodes.IsUnknown() {
+		for _, elem := range config.RetryableStatusCodes.Elements() {
+			if code, ok := elem.(types.Int64); ok && !code.IsNull() && !code.IsUnknown() {
+				policy.extraStatusCodes = append(policy.extraStatusCodes, int(code.ValueInt64()))
+			}
+		}
+	}
+	return policy, diags
+}
+
+// resolveRequestLimits reads the client-side rate and concurrency limits; zero means unset,
+// i.e. unlimited. Attribute values are checked by their schema validators.
+func resolveRequestLimits(config providerModel) (float64, int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var rate float64
+	if !config.MaxRequestsPerSecond.IsNull() && !config.MaxRequestsPerSecond.IsUnknown() {
+		rate = config.MaxRequestsPerSecond.ValueFloat64()
+	} else if env := strings.TrimSpace(os.Getenv("VIDOS_MAX_REQUESTS_PER_SECOND")); env != "" {
+		r, err := strconv.ParseFloat(env, 64)
+		if err != nil || !(r > 0) || math.IsInf(r, 0) {
+			diags.AddAttributeError(path.Root("max_requests_per_second"), "Invalid VIDOS_MAX_REQUESTS_PER_SECOND", fmt.Sprintf("%q is not a positive number", env))
+		}
+		rate = r
+	}
+
+	var concurrent int
+	if !config.MaxConcurrentRequests.IsNull() && !config.MaxConcurrentRequests.IsUnknown() {
+		concurrent = int(config.MaxConcurrentRequests.ValueInt64())
+	} else if env := strings.TrimSpace(os.Getenv("VIDOS_MAX_CONCURRENT_REQUESTS")); env != "" {
+		n, err := strconv.Atoi(env)
+		if err != nil || n < 1 {
+			diags.AddAttributeError(path.Root("max_concurrent_requests"), "Invalid VIDOS_MAX_CONCURRENT_REQUESTS", fmt.Sprintf("%q is not a positive integer", env))
+		}
+		concurrent = n
+	}
+	return rate, concurrent, diags
+}
+
+// resolveHTTPTransport builds a transport from the TLS and proxy settings, or returns nil when
+// none is set so the default transport (which honors HTTPS_PROXY/NO_PROXY) is used.
+func resolveHTTPTransport(config providerModel, domain string, endpoints map[string]string) (*http.Transport, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var cfg transportConfig
+
+	readPEM := func(attr types.String, env string, p path.Path) []byte {
+		filePath := getFirstNonEmpty(attr, os.Getenv(env))
+		if filePath == "" {
+			return nil
+		}
+		b, err := os.ReadFile(expandHome(filePath))
+		if err != nil {
+			diags.AddAttributeError(p, "Unreadable file", err.Error())
+		}
+		return b
+	}
+
+	if caBundle := getFirstNonEmpty(config.CABundle, ""); caBundle != "" {
+		if !config.CABundleFile.IsNull() {
+			diags.AddAttributeError(path.Root("ca_bundle"), "Conflicting configuration", "Set only one of ca_bundle and ca_bundle_file.")
+		}
+		cfg.caBundlePEM = []byte(caBundle)
+	} else {
+		cfg.caBundlePEM = readPEM(config.CABundleFile, "VIDOS_CA_BUNDLE", path.Root("ca_bundle_file"))
+	}
+	cfg.clientCertPEM = readPEM(config.ClientCertificateFile, "VIDOS_CLIENT_CERTIFICATE_FILE", path.Root("client_certificate_file"))
+	cfg.clientKeyPEM = readPEM(config.ClientKeyFile, "VIDOS_CLIENT_KEY_FILE", path.Root("client_key_file"))
+
+	cfg.proxyURL = getFirstNonEmpty(config.ProxyURL, os.Getenv("VIDOS_PROXY_URL"))
+	if cfg.proxyURL != "" {
+		if err := validateProxyURL(cfg.proxyURL); err != nil {
+			diags.AddAttributeError(path.Root("proxy_url"), "Invalid proxy URL", err.Error())
+		}
+	}
+
+	cfg.insecure = getBool(&diags, config.Insecure, "VIDOS_INSECURE", path.Root("insecure"))
+	if cfg.insecure && isDefaultDomainHost(domain) && len(endpoints) == 0 {
+		diags.AddAttributeWarning(path.Root("insecure"), "insecure has no effect",
+			"Certificates of "+defaultDomain+" hosts are always verified. insecure only applies to a custom domain or endpoint overrides.")
+	}
+
+	if diags.HasError() || cfg.isZero() {
+		return nil, diags
+	}
+	transport, err := newHTTPTransport(cfg)
+	if err != nil {
+		diags.AddError("Invalid TLS configuration", err.Error())
+		return nil, diags
+	}
+	return transport, diags
+}
+
+// resolveCredentialsProfile loads the selected profile from the shared credentials file. Both
+// are optional, but naming a file or profile that does not exist is an error.
+func resolveCredentialsProfile(config providerModel) (credentialsProfile, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	filePath := getFirstNonEmpty(config.SharedCredentialsFile, os.Getenv("VIDOS_SHARED_CREDENTIALS_FILE"))
+	explicitFile := filePath != ""
+	if explicitFile {
+		filePath = expandHome(filePath)
+	} else {
+		p, err := defaultSharedCredentialsFile()
+		if err != nil {
+			// Without a home directory there is no default file to read.
+			return credentialsProfile{}, diags
+		}
+		filePath = p
+	}
+
+	profileName := getFirstNonEmpty(config.Profile, os.Getenv("VIDOS_PROFILE"))
+	explicitProfile := profileName != ""
+	if !explicitProfile {
+		profileName = defaultProfileName
+	}
+
+	profiles, found, err := loadSharedCredentials(filePath)
+	if err != nil {
+		diags.AddAttributeError(path.Root("shared_credentials_file"), "Invalid shared credentials file", err.Error())
+		return credentialsProfile{}, diags
+	}
+	if !found {
+		if explicitFile {
+			diags.AddAttributeError(path.Root("shared_credentials_file"), "Shared credentials file not found", fmt.Sprintf("No file exists at %s.", filePath))
+		} else if explicitProfile {
+			diags.AddAttributeError(path.Root("profile"), "Profile not found", fmt.Sprintf("Profile %q was selected, but no shared credentials file exists at %s.", profileName, filePath))
+		}
+		return credentialsProfile{}, diags
+	}
+
+	profile, ok := profiles[profileName]
+	if !ok && explicitProfile {
+		diags.AddAttributeError(path.Root("profile"), "Profile not found", fmt.Sprintf("Profile %q is not defined in %s.", profileName, filePath))
+	}
+	return profile, diags
+}
+
+// expandHome expands a leading ~/ to the user's home directory.
+func expandHome(p string) string {
+	rest, ok := strings.CutPrefix(p, "~/")
+	if !ok {
+		return p
+	}
+	home, err := userHomeDirFn()
+	if err != nil {
+		return p
+	}
+	return filepath.Join(home, rest)
+}
+
+// firstNonEmpty returns the first non-empty value.
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
 func getFirstNonEmpty(attr types.String, env string) string {
 	if !attr.IsNull() && !attr.IsUnknown() {
 		return strings.TrimSpace(attr.ValueString())
@@ -113,6 +674,22 @@ func getFirstNonEmpty(attr types.String, env string) string {
 	return strings.TrimSpace(env)
 }
 
+// getBool returns the attribute value, else the boolean env var, else false.
+func getBool(diags *diag.Diagnostics, attr types.Bool, env string, attrPath path.Path) bool {
+	if !attr.IsNull() && !attr.IsUnknown() {
+		return attr.ValueBool()
+	}
+	raw := strings.TrimSpace(os.Getenv(env))
+	if raw == "" {
+		return false
+	}
+	v, err := strconv.ParseBool(raw)
+	if err != nil {
+		diags.AddAttributeError(attrPath, "Invalid "+env, fmt.Sprintf("%q is not a boolean", raw))
+	}
+	return v
+}
+
 func buildManagementBaseURL(service, region, domain string) string {
 	return "https://" + service + ".management." + region + "." + domain
 }
@@ -122,8 +699,10 @@ func (p *VidosProvider) Resources(_ context.Context) []func() resource.Resource
 		NewIamApiKeyResource,
 		NewIamPolicyResource,
 		NewIamApiKeyPolicyAttachmentResource,
+		NewIamApiKeyPoliciesExclusiveResource,
 		NewIamServiceRoleResource,
 		NewIamServiceRolePolicyAttachmentResource,
+		NewIamServiceRolePoliciesExclusiveResource,
 		NewResolverConfigurationResource,
 		NewResolverInstanceResource,
 		NewVerifierConfigurationResource,
@@ -138,10 +717,46 @@ func (p *VidosProvider) Resources(_ context.Context) []func() resource.Resource
 }
 
 func (p *VidosProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		NewCallerIdentityDataSource,
+		NewIamApiKeyDataSource,
+		NewIamPolicyDataSource,
+		NewIamPolicyDocumentDataSource,
+		NewIamManagedPolicyDataSource,
+		NewIamManagedPoliciesDataSource,
+		NewIamPolicySimulationDataSource,
+		NewIamServiceRoleDataSource,
+		NewResolverConfigurationDataSource,
+		NewResolverInstanceDataSource,
+		NewResolverConfigurationsDataSource,
+		NewResolverInstancesDataSource,
+		NewVerifierConfigurationDataSource,
+		NewVerifierInstanceDataSource,
+		NewVerifierConfigurationsDataSource,
+		NewVerifierInstancesDataSource,
+		NewValidatorConfigurationDataSource,
+		NewValidatorInstanceDataSource,
+		NewValidatorConfigurationsDataSource,
+		NewValidatorInstancesDataSource,
+		NewAuthorizerConfigurationDataSource,
+		NewAuthorizerInstanceDataSource,
+		NewAuthorizerConfigurationsDataSource,
+		NewAuthorizerInstancesDataSource,
+		NewGatewayConfigurationDataSource,
+		NewGatewayInstanceDataSource,
+		NewGatewayConfigurationsDataSource,
+		NewGatewayInstancesDataSource,
+	}
+}
+
+func (p *VidosProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewIamApiKeyEphemeralResource,
+	}
 }
 
 var _ provider.Provider = (*VidosProvider)(nil)
+var _ provider.ProviderWithEphemeralResources = (*VidosProvider)(nil)
 
 // Helper to add a nice error when required config is unknown.
 func requireKnownString(diags *diag.Diagnostics, val types.String, attrPath path.Path, name string) (string, bool) {
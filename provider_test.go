@@ -14,6 +14,7 @@ import (
 )
 
 func TestBuildProviderConfig_DefaultsAndApiKeyFromEnv(t *testing.T) {
+	isolateCredentials(t)
 	oldRegion := os.Getenv("VIDOS_REGION")
 	oldKey := os.Getenv("VIDOS_API_KEY")
 	t.Cleanup(func() {
@@ -60,6 +61,7 @@ func TestBuildProviderConfig_RegionFromConfigPreferredOverEnv(t *testing.T) {
 }
 
 func TestBuildProviderConfig_MissingAPIKey(t *testing.T) {
+	isolateCredentials(t)
 	oldKey := os.Getenv("VIDOS_API_KEY")
 	t.Cleanup(func() { _ = os.Setenv("VIDOS_API_KEY", oldKey) })
 	_ = os.Setenv("VIDOS_API_KEY", "")
@@ -70,6 +72,56 @@ func TestBuildProviderConfig_MissingAPIKey(t *testing.T) {
 	}
 }
 
+func TestBuildProviderConfig_DomainAndEndpoints(t *testing.T) {
+	t.Setenv("VIDOS_API_KEY", "secret")
+	t.Setenv("VIDOS_DOMAIN", "staging.example.com")
+	t.Setenv("VIDOS_RESOLVER_ENDPOINT", "http://localhost:9000/")
+	t.Setenv("VIDOS_IAM_ENDPOINT", "http://ignored.example.com")
+
+	cfg, diags := buildProviderConfig(providerModel{
+		Endpoints: &providerEndpointsModel{Iam: types.StringValue("https://iam.mock.internal")},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", diags)
+	}
+	if cfg.domain != "staging.example.com" {
+		t.Fatalf("expected domain from VIDOS_DOMAIN, got %q", cfg.domain)
+	}
+	want := map[string]string{
+		"iam":      "https://iam.mock.internal",
+		"resolver": "http://localhost:9000",
+	}
+	if len(cfg.endpoints) != len(want) {
+		t.Fatalf("unexpected endpoints: %#v", cfg.endpoints)
+	}
+	for service, endpoint := range want {
+		if cfg.endpoints[service] != endpoint {
+			t.Fatalf("unexpected %s endpoint: %q", service, cfg.endpoints[service])
+		}
+	}
+
+	cfg, diags = buildProviderConfig(providerModel{Domain: types.StringValue("example.org")})
+	if diags.HasError() || cfg.domain != "example.org" {
+		t.Fatalf("expected configured domain to win over env, got %q %#v", cfg.domain, diags)
+	}
+}
+
+func TestBuildProviderConfig_InvalidDomainAndEndpoint(t *testing.T) {
+	t.Setenv("VIDOS_API_KEY", "secret")
+	t.Setenv("VIDOS_GATEWAY_ENDPOINT", "gateway.example.com")
+
+	_, diags := buildProviderConfig(providerModel{Domain: types.StringValue("https://example.com")})
+	if diags.ErrorsCount() != 2 {
+		t.Fatalf("expected domain and endpoint errors, got %#v", diags)
+	}
+	for i, want := range []path.Path{path.Root("domain"), path.Root("endpoints").AtName("gateway")} {
+		withPath, ok := diags.Errors()[i].(diag.DiagnosticWithPath)
+		if !ok || !withPath.Path().Equal(want) {
+			t.Fatalf("expected error at %s, got %#v", want, diags.Errors()[i])
+		}
+	}
+}
+
 func TestGetFirstNonEmpty_TrimsAndPrefersConfig(t *testing.T) {
 	if got := getFirstNonEmpty(types.StringValue("  hi  "), "env"); got != "hi" {
 		t.Fatalf("unexpected value: %q", got)
@@ -126,6 +178,8 @@ func TestRequireKnownString(t *testing.T) {
 }
 
 func TestProviderConfigure_SetsClientData(t *testing.T) {
+	t.Setenv("VIDOS_SKIP_API_VERSION_CHECK", "true")
+	t.Setenv("VIDOS_SKIP_CREDENTIALS_VALIDATION", "true")
 	ctx := context.Background()
 
 	p := &VidosProvider{version: "test"}
@@ -144,13 +198,10 @@ func TestProviderConfigure_SetsClientData(t *testing.T) {
 
 	cfg := tfsdk.Config{
 		Schema: schemaResp.Schema,
-		Raw: tftypes.NewValue(
-			schemaResp.Schema.Type().TerraformType(ctx),
-			map[string]tftypes.Value{
-				"region":  regionTF,
-				"api_key": keyTF,
-			},
-		),
+		Raw: objectValue(schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object), map[string]tftypes.Value{
+			"region":  regionTF,
+			"api_key": keyTF,
+		}),
 	}
 
 	var resp provider.ConfigureResponse
@@ -162,8 +213,8 @@ func TestProviderConfigure_SetsClientData(t *testing.T) {
 	if resp.ResourceData == nil || resp.DataSourceData == nil {
 		t.Fatalf("expected provider data to be set")
 	}
-	if resp.ResourceData != resp.DataSourceData {
-		t.Fatalf("expected resource and datasource data to match")
+	if resp.ResourceData != resp.DataSourceData || resp.ResourceData != resp.EphemeralResourceData {
+		t.Fatalf("expected resource, datasource and ephemeral resource data to match")
 	}
 	if _, ok := resp.ResourceData.(*APIClient); !ok {
 		t.Fatalf("expected *APIClient, got %T", resp.ResourceData)
@@ -171,6 +222,7 @@ func TestProviderConfigure_SetsClientData(t *testing.T) {
 }
 
 func TestProviderConfigure_MissingApiKeyAddsError(t *testing.T) {
+	isolateCredentials(t)
 	ctx := context.Background()
 
 	oldKey := os.Getenv("VIDOS_API_KEY")
@@ -190,13 +242,9 @@ func TestProviderConfigure_MissingApiKeyAddsError(t *testing.T) {
 	// api_key omitted (null) should be rejected unless env var is set.
 	cfg := tfsdk.Config{
 		Schema: schemaResp.Schema,
-		Raw: tftypes.NewValue(
-			schemaResp.Schema.Type().TerraformType(ctx),
-			map[string]tftypes.Value{
-				"region":  regionTF,
-				"api_key": tftypes.NewValue(tftypes.String, nil),
-			},
-		),
+		Raw: objectValue(schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object), map[string]tftypes.Value{
+			"region": regionTF,
+		}),
 	}
 
 	var resp provider.ConfigureResponse
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// limiterWaitFn exists to make rate limiting testable without real delays. Production code
+// waits on a timer, returning early when ctx is done.
+var limiterWaitFn = func(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// requestLimiter paces requests per service host. Terraform runs many operations in
+// parallel and all of them share the provider's APIClient, so the limits and any Retry-After
+// pause apply across goroutines.
+type requestLimiter struct {
+	// requestsPerSecond is the token bucket rate per host; 0 means unlimited.
+	requestsPerSecond float64
+	// maxConcurrent caps in-flight requests per host; 0 means unlimited.
+	maxConcurrent int
+
+	mu    sync.Mutex
+	hosts map[string]*hostLimiter
+}
+
+type hostLimiter struct {
+	// slots holds one token per in-flight request; nil when concurrency is unlimited.
+	slots chan struct{}
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+	// pausedUntil is set from Retry-After so every request to the host waits it out.
+	pausedUntil time.Time
+}
+
+func newRequestLimiter(requestsPerSecond float64, maxConcurrent int) *requestLimiter {
+	return &requestLimiter{
+		requestsPerSecond: requestsPerSecond,
+		maxConcurrent:     maxConcurrent,
+		hosts:             map[string]*hostLimiter{},
+	}
+}
+
+// burst is the token bucket size: one second worth of requests, at least one.
+func (l *requestLimiter) burst() float64 {
+	return math.Max(1, math.Ceil(l.requestsPerSecond))
+}
+
+func (l *requestLimiter) host(host string) *hostLimiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	h, ok := l.hosts[host]
+	if !ok {
+		h = &hostLimiter{tokens: l.burst()}
+		if l.maxConcurrent > 0 {
+			h.slots = make(chan struct{}, l.maxConcurrent)
+		}
+		l.hosts[host] = h
+	}
+	return h
+}
+
+// acquire blocks until a request to host may be sent and returns the function releasing its
+// concurrency slot. It fails only when ctx is done first.
+func (l *requestLimiter) acquire(ctx context.Context, host string) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	h := l.host(host)
+
+	release := func() {}
+	if h.slots != nil {
+		select {
+		case h.slots <- struct{}{}:
+			release = func() { <-h.slots }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if wait := h.reserve(nowFn(), l.requestsPerSecond, l.burst()); wait > 0 {
+		if err := limiterWaitFn(ctx, wait); err != nil {
+			release()
+			return nil, err
+		}
+	}
+	return release, nil
+}
+
+// reserve takes a token and returns how long the caller must wait before using it. Tokens
+// may go negative, which queues callers in arrival order.
+func (h *hostLimiter) reserve(now time.Time, rate, burst float64) time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var wait time.Duration
+	if rate > 0 {
+		if !h.last.IsZero() {
+			h.tokens = math.Min(burst, h.tokens+now.Sub(h.last).Seconds()*rate)
+		}
+		h.last = now
+		h.tokens--
+		if h.tokens < 0 {
+			wait = time.Duration(-h.tokens / rate * float64(time.Second))
+		}
+	}
+	if pause := h.pausedUntil.Sub(now); pause > wait {
+		wait = pause
+	}
+	return wait
+}
+
+// pause makes every request to host wait until the given time, as asked by a Retry-After.
+func (l *requestLimiter) pause(host string, until time.Time) {
+	if l == nil {
+		return
+	}
+	h := l.host(host)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if until.After(h.pausedUntil) {
+		h.pausedUntil = until
+	}
+}
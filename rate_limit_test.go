@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestHostLimiter_Reserve(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newRequestLimiter(2, 0)
+	h := l.host("iam.example.com")
+
+	// A full bucket lets a burst of one second's worth through, then queues callers.
+	waits := []time.Duration{
+		h.reserve(now, 2, l.burst()),
+		h.reserve(now, 2, l.burst()),
+		h.reserve(now, 2, l.burst()),
+		h.reserve(now, 2, l.burst()),
+	}
+	want := []time.Duration{0, 0, 500 * time.Millisecond, time.Second}
+	for i := range want {
+		if waits[i] != want[i] {
+			t.Fatalf("unexpected waits: %v", waits)
+		}
+	}
+
+	// Once the queued tokens are paid back the bucket refills up to its burst size.
+	now = now.Add(3 * time.Second)
+	if w := h.reserve(now, 2, l.burst()); w != 0 {
+		t.Fatalf("expected refilled bucket, got wait %s", w)
+	}
+}
+
+func TestRequestLimiter_PauseAppliesPerHost(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newRequestLimiter(0, 0)
+	l.pause("iam.example.com", now.Add(3*time.Second))
+	// An earlier pause never shortens a later one.
+	l.pause("iam.example.com", now.Add(time.Second))
+
+	if w := l.host("iam.example.com").reserve(now, 0, l.burst()); w != 3*time.Second {
+		t.Fatalf("expected paused host to wait 3s, got %s", w)
+	}
+	if w := l.host("gateway.example.com").reserve(now, 0, l.burst()); w != 0 {
+		t.Fatalf("expected other host not to wait, got %s", w)
+	}
+}
+
+func TestRequestLimiter_ConcurrencyCap(t *testing.T) {
+	l := newRequestLimiter(0, 1)
+
+	release, err := l.acquire(context.Background(), "iam.example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := l.acquire(ctx, "iam.example.com"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected second request to block until the deadline, got %v", err)
+	}
+	otherRelease, err := l.acquire(context.Background(), "gateway.example.com")
+	if err != nil {
+		t.Fatalf("expected other host to have its own slots: %s", err)
+	}
+	otherRelease()
+
+	release()
+	release, err = l.acquire(context.Background(), "iam.example.com")
+	if err != nil {
+		t.Fatalf("expected slot after release: %s", err)
+	}
+	release()
+
+	var nilLimiter *requestLimiter
+	if release, err := nilLimiter.acquire(context.Background(), "iam.example.com"); err != nil || release == nil {
+		t.Fatalf("expected nil limiter to allow everything")
+	}
+}
+
+func TestAPIClient_doJSONInternal_RetryAfterPausesOtherRequests(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	origNow, origSleep, origWait := nowFn, sleepFn, limiterWaitFn
+	nowFn = func() time.Time { return now }
+	sleepFn = func(time.Duration) {}
+	var waits []time.Duration
+	limiterWaitFn = func(_ context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+	t.Cleanup(func() { nowFn, sleepFn, limiterWaitFn = origNow, origSleep, origWait })
+
+	throttled := true
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if r.URL.Host == "iam.example.com" && throttled {
+			throttled = false
+			return httpResponse(429, map[string]string{"Retry-After": "3"}, `{"code":"TooMany","message":"slow down"}`), nil
+		}
+		return httpResponse(200, nil, `{}`), nil
+	}))
+	c.limiter = newRequestLimiter(0, 0)
+
+	if diags := c.doJSON(context.Background(), "GET", "https://iam.example.com/a", nil, nil); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", diags)
+	}
+	// The clock is frozen, so the retried request and any other request to the host still
+	// see the pause; requests to other hosts don't.
+	waits = nil
+	if diags := c.doJSON(context.Background(), "GET", "https://iam.example.com/b", nil, nil); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", diags)
+	}
+	if diags := c.doJSON(context.Background(), "GET", "https://gateway.example.com/c", nil, nil); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", diags)
+	}
+	if len(waits) != 1 || waits[0] != 3*time.Second {
+		t.Fatalf("expected only the throttled host to be paused, got %v", waits)
+	}
+}
+
+func TestAPIClient_doJSONInternal_LimiterDeadline(t *testing.T) {
+	origWait := limiterWaitFn
+	limiterWaitFn = func(context.Context, time.Duration) error { return context.DeadlineExceeded }
+	t.Cleanup(func() { limiterWaitFn = origWait })
+
+	c := newTestClient(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		t.Fatalf("no request expected")
+		return nil, nil
+	}))
+	c.limiter = newRequestLimiter(1, 0)
+	c.limiter.pause("example.com", nowFn().Add(time.Hour))
+
+	diags := c.doJSON(context.Background(), "GET", "https://example.com/x", nil, nil)
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Operation timed out" {
+		t.Fatalf("expected timeout, got %#v", diags)
+	}
+}
+
+func TestResolveRequestLimits(t *testing.T) {
+	t.Setenv("VIDOS_MAX_REQUESTS_PER_SECOND", "")
+	t.Setenv("VIDOS_MAX_CONCURRENT_REQUESTS", "")
+
+	rate, concurrent, diags := resolveRequestLimits(providerModel{})
+	if diags.HasError() || rate != 0 || concurrent != 0 {
+		t.Fatalf("expected unlimited by default, got %v %v %#v", rate, concurrent, diags)
+	}
+
+	t.Setenv("VIDOS_MAX_REQUESTS_PER_SECOND", "0.5")
+	t.Setenv("VIDOS_MAX_CONCURRENT_REQUESTS", "4")
+	rate, concurrent, diags = resolveRequestLimits(providerModel{})
+	if diags.HasError() || rate != 0.5 || concurrent != 4 {
+		t.Fatalf("expected limits from env, got %v %v %#v", rate, concurrent, diags)
+	}
+
+	rate, concurrent, diags = resolveRequestLimits(providerModel{MaxRequestsPerSecond: types.Float64Value(10), MaxConcurrentRequests: types.Int64Value(2)})
+	if diags.HasError() || rate != 10 || concurrent != 2 {
+		t.Fatalf("expected attributes to win, got %v %v %#v", rate, concurrent, diags)
+	}
+
+	for _, env := range []string{"fast", "-1", "0", "NaN"} {
+		t.Setenv("VIDOS_MAX_REQUESTS_PER_SECOND", env)
+		if _, _, diags := resolveRequestLimits(providerModel{}); !diags.HasError() {
+			t.Fatalf("%q: expected error", env)
+		}
+	}
+	t.Setenv("VIDOS_MAX_REQUESTS_PER_SECOND", "")
+	t.Setenv("VIDOS_MAX_CONCURRENT_REQUESTS", "0")
+	if _, _, diags := resolveRequestLimits(providerModel{}); !diags.HasError() {
+		t.Fatalf("expected error for zero concurrency")
+	}
+}
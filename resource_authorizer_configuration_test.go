@@ -44,7 +44,7 @@ func TestAuthorizerConfigurationResource_ReadIntoState_Success(t *testing.T) {
 	}))
 
 	r := &AuthorizerConfigurationResource{client: c}
-	var state configurationModel
+	var state configurationSettingsModel
 
 	found, diags := r.readIntoState(context.Background(), "rid", &state)
 	if diags.HasError() {
@@ -70,7 +70,7 @@ func TestAuthorizerConfigurationResource_ReadIntoState_NotFound(t *testing.T) {
 	}))
 
 	r := &AuthorizerConfigurationResource{client: c}
-	var state configurationModel
+	var state configurationSettingsModel
 
 	found, diags := r.readIntoState(context.Background(), "rid", &state)
 	if diags.HasError() {
@@ -87,7 +87,7 @@ func TestAuthorizerConfigurationResource_ReadIntoState_Error(t *testing.T) {
 	}))
 
 	r := &AuthorizerConfigurationResource{client: c}
-	var state configurationModel
+	var state configurationSettingsModel
 
 	_, diags := r.readIntoState(context.Background(), "rid", &state)
 	if !diags.HasError() {
@@ -117,23 +117,23 @@ func TestAuthorizerConfigurationResource_Create_SendsExpectedPayload(t *testing.
 
 	r := &AuthorizerConfigurationResource{client: c}
 
-	planModel := configurationModel{
+	planModel := configurationSettingsModel{configurationModel: configurationModel{
 		ResourceID: types.StringNull(),
 		Name:       types.StringValue("n"),
-		Values:     types.StringValue(`{"a":1}`),
-	}
-	configModel := configurationModel{
+		Values:     jsonStringValue(`{"a":1}`),
+	}}
+	configModel := configurationSettingsModel{configurationModel: configurationModel{
 		ResourceID: types.StringNull(),
 		Name:       types.StringValue("n"),
-		Values:     types.StringValue(`{"a":1}`),
-	}
+		Values:     jsonStringValue(`{"a":1}`),
+	}}
 
 	var req resource.CreateRequest
-	req.Plan = configurationPlan(t, planModel)
-	req.Config = configurationConfig(t, configModel)
+	req.Plan = configurationSettingsPlan(t, authorizerConfigurationSchema(), planModel)
+	req.Config = configurationSettingsConfig(t, authorizerConfigurationSchema(), configModel)
 
 	var resp resource.CreateResponse
-	initConfigurationState(t, &resp.State)
+	initSchemaState(t, &resp.State, authorizerConfigurationSchema())
 
 	oldRead := cryptoRandRead
 	cryptoRandRead = func(b []byte) (int, error) {
@@ -166,23 +166,23 @@ func TestAuthorizerConfigurationResource_Create_InvalidValuesJSONAddsDiagnostics
 
 	r := &AuthorizerConfigurationResource{client: c}
 
-	planModel := configurationModel{
+	planModel := configurationSettingsModel{configurationModel: configurationModel{
 		ResourceID: types.StringNull(),
 		Name:       types.StringValue("n"),
-		Values:     types.StringValue(`{bad json}`),
-	}
-	configModel := configurationModel{
+		Values:     jsonStringValue(`{bad json}`),
+	}}
+	configModel := configurationSettingsModel{configurationModel: configurationModel{
 		ResourceID: types.StringNull(),
 		Name:       types.StringValue("n"),
-		Values:     types.StringValue(`{bad json}`),
-	}
+		Values:     jsonStringValue(`{bad json}`),
+	}}
 
 	var req resource.CreateRequest
-	req.Plan = configurationPlan(t, planModel)
-	req.Config = configurationConfig(t, configModel)
+	req.Plan = configurationSettingsPlan(t, authorizerConfigurationSchema(), planModel)
+	req.Config = configurationSettingsConfig(t, authorizerConfigurationSchema(), configModel)
 
 	var resp resource.CreateResponse
-	initConfigurationState(t, &resp.State)
+	initSchemaState(t, &resp.State, authorizerConfigurationSchema())
 
 	r.Create(context.Background(), req, &resp)
 	if !resp.Diagnostics.HasError() {
@@ -199,13 +199,13 @@ func TestAuthorizerConfigurationResource_Read_NotFoundRemovesResource(t *testing
 	}))
 
 	r := &AuthorizerConfigurationResource{client: c}
-	stateModel := configurationModel{ResourceID: types.StringValue("rid")}
+	stateModel := configurationSettingsModel{configurationModel: configurationModel{ResourceID: types.StringValue("rid")}}
 
 	var req resource.ReadRequest
-	req.State = configurationState(t, stateModel)
+	req.State = configurationSettingsState(t, authorizerConfigurationSchema(), stateModel)
 
 	var resp resource.ReadResponse
-	initConfigurationState(t, &resp.State)
+	initSchemaState(t, &resp.State, authorizerConfigurationSchema())
 
 	r.Read(context.Background(), req, &resp)
 	if resp.Diagnostics.HasError() {
@@ -238,17 +238,17 @@ func TestAuthorizerConfigurationResource_Update_SendsExpectedPayload(t *testing.
 
 	r := &AuthorizerConfigurationResource{client: c}
 
-	planModel := configurationModel{
+	planModel := configurationSettingsModel{configurationModel: configurationModel{
 		ResourceID: types.StringValue("rid"),
 		Name:       types.StringValue("n2"),
-		Values:     types.StringValue(`{"b":2}`),
-	}
+		Values:     jsonStringValue(`{"b":2}`),
+	}}
 
 	var req resource.UpdateRequest
-	req.Plan = configurationPlan(t, planModel)
+	req.Plan = configurationSettingsPlan(t, authorizerConfigurationSchema(), planModel)
 
 	var resp resource.UpdateResponse
-	initConfigurationState(t, &resp.State)
+	initSchemaState(t, &resp.State, authorizerConfigurationSchema())
 
 	r.Update(context.Background(), req, &resp)
 	if resp.Diagnostics.HasError() {
@@ -280,10 +280,10 @@ func TestAuthorizerConfigurationResource_Delete_CallsDeleteConfiguration(t *test
 	}))
 
 	r := &AuthorizerConfigurationResource{client: c}
-	stateModel := configurationModel{ResourceID: types.StringValue("rid")}
+	stateModel := configurationSettingsModel{configurationModel: configurationModel{ResourceID: types.StringValue("rid")}}
 
 	var req resource.DeleteRequest
-	req.State = configurationState(t, stateModel)
+	req.State = configurationSettingsState(t, authorizerConfigurationSchema(), stateModel)
 
 	var resp resource.DeleteResponse
 	r.Delete(context.Background(), req, &resp)
@@ -298,7 +298,7 @@ func TestAuthorizerConfigurationResource_Delete_CallsDeleteConfiguration(t *test
 func TestAuthorizerConfigurationResource_ImportState_Passthrough(t *testing.T) {
 	r := &AuthorizerConfigurationResource{}
 	var resp resource.ImportStateResponse
-	initConfigurationState(t, &resp.State)
+	initSchemaState(t, &resp.State, authorizerConfigurationSchema())
 
 	r.ImportState(context.Background(), resource.ImportStateRequest{ID: "rid"}, &resp)
 	if resp.Diagnostics.HasError() {
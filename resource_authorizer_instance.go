@@ -10,14 +10,16 @@ import (
 )
 
 type AuthorizerInstanceResource struct {
-	instanceResource
+	instanceSettingsResource
 }
 
 func NewAuthorizerInstanceResource() resource.Resource {
 	return &AuthorizerInstanceResource{
-		instanceResource: instanceResource{
-			baseURL: func(client *APIClient) string {
-				return client.authorizerBaseURL()
+		instanceSettingsResource: instanceSettingsResource{
+			instanceResource: instanceResource{
+				baseURL: func(client *APIClient, region string) string {
+					return client.regionalBaseURL("authorizer", region)
+				},
 			},
 		},
 	}
@@ -26,12 +28,14 @@ func NewAuthorizerInstanceResource() resource.Resource {
 var _ resource.Resource = (*AuthorizerInstanceResource)(nil)
 var _ resource.ResourceWithConfigure = (*AuthorizerInstanceResource)(nil)
 var _ resource.ResourceWithImportState = (*AuthorizerInstanceResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*AuthorizerInstanceResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*AuthorizerInstanceResource)(nil)
 
 func (r *AuthorizerInstanceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_authorizer_instance"
 }
 
-func (r *AuthorizerInstanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *AuthorizerInstanceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"resource_id": schema.StringAttribute{
@@ -52,14 +56,21 @@ func (r *AuthorizerInstanceResource) Schema(_ context.Context, _ resource.Schema
 				Description: "Authorizer configuration resource ID to apply to this instance.",
 			},
 			"inline_configuration": schema.StringAttribute{
+				CustomType:  jsonStringType{},
 				Optional:    true,
 				Computed:    true,
-				Description: "Inline authorizer configuration JSON (string). If omitted, the server may default this to an empty object.",
+				Description: "Inline authorizer configuration JSON (string). If omitted, the server may default this to an empty object. Conflicts with inline_settings; computed from it when that is used.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
-			"endpoint": instanceEndpointSchemaAttribute(),
+			"inline_settings": authorizerSettingsSchemaAttribute("Typed inline authorizer configuration, validated at plan time. Alternative to inline_configuration."),
+			"endpoint":        instanceEndpointSchemaAttribute(),
+			"status":          instanceStatusSchemaAttribute(),
+			"region":          regionSchemaAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
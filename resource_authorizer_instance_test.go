@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// authorizerValidateSettings builds typed authorizer settings from validate.skip (a bool, or nil
+// for null) and the validator instance, which is null when validatorID is empty.
+func authorizerValidateSettings(t *testing.T, skip any, validatorID string) types.Object {
+	t.Helper()
+	typ := authorizerSettingsSchemaAttribute("").GetType()
+	tfType := typ.TerraformType(context.Background()).(tftypes.Object)
+	policiesType := tfType.AttributeTypes["policies"].(tftypes.Object)
+	validateType := policiesType.AttributeTypes["validate"].(tftypes.Object)
+	refType := validateType.AttributeTypes["validator"].(tftypes.Object)
+
+	validate := map[string]tftypes.Value{
+		"skip":      tftypes.NewValue(tftypes.Bool, skip),
+		"validator": tftypes.NewValue(refType, nil),
+	}
+	if validatorID != "" {
+		validate["validator"] = tftypes.NewValue(refType, map[string]tftypes.Value{
+			"type":         tftypes.NewValue(tftypes.String, "instance"),
+			"resource_id":  tftypes.NewValue(tftypes.String, validatorID),
+			"service_role": tftypes.NewValue(refType.AttributeTypes["service_role"], nil),
+		})
+	}
+	raw := tftypes.NewValue(tfType, map[string]tftypes.Value{
+		"policies": tftypes.NewValue(policiesType, map[string]tftypes.Value{
+			"validate": tftypes.NewValue(validateType, validate),
+		}),
+	})
+	out, err := typ.ValueFromTerraform(context.Background(), raw)
+	if err != nil {
+		t.Fatalf("settings ValueFromTerraform: %s", err)
+	}
+	return out.(types.Object)
+}
+
+func TestAuthorizerInstanceResource_Create_WithInlineSettings(t *testing.T) {
+	var gotBody string
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		switch r.Method {
+		case http.MethodPost:
+			if !strings.HasPrefix(r.URL.String(), "https://authorizer.management.eu.example.com/") {
+				return httpResponse(500, nil, "unexpected url: "+r.URL.String()), nil
+			}
+			b, _ := io.ReadAll(r.Body)
+			gotBody = string(b)
+			return httpResponse(200, nil, `{}`), nil
+		case http.MethodGet:
+			return httpResponse(200, nil, `{"instance":{"resourceId":"rid","name":"n","inlineConfiguration":{},"endpoint":"https://e","status":"RUNNING"}}`), nil
+		default:
+			return httpResponse(500, nil, "unexpected"), nil
+		}
+	}))
+	r := NewAuthorizerInstanceResource().(*AuthorizerInstanceResource)
+	r.client = c
+	s := authorizerInstanceSchema()
+
+	settings := authorizerValidateSettings(t, nil, "validator-1")
+
+	plan := instanceSettingsModel{
+		instanceModel: instanceModel{
+			ResourceID:          types.StringValue("rid"),
+			Name:                types.StringValue("n"),
+			InlineConfiguration: structuredSettingsJSON(context.Background(), settings),
+			Endpoint:            types.StringUnknown(),
+			Status:              types.StringUnknown(),
+		},
+		InlineSettings: settings,
+	}
+	config := instanceSettingsModel{
+		instanceModel:  instanceModel{ResourceID: types.StringValue("rid"), Name: types.StringValue("n")},
+		InlineSettings: settings,
+	}
+
+	var resp resource.CreateResponse
+	initSchemaState(t, &resp.State, s)
+	r.Create(context.Background(), resource.CreateRequest{Config: instanceSettingsConfig(t, s, config), Plan: instanceSettingsPlan(t, s, plan)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+	if !strings.Contains(gotBody, `"inlineConfiguration":{"policies":{"validate":{"validator":{"resourceId":"validator-1","type":"instance"}}}}`) {
+		t.Fatalf("expected rendered policies in payload, got: %s", gotBody)
+	}
+}
+
+func TestAuthorizerConfigurationResource_ModifyPlan_DropsRemovedValidator(t *testing.T) {
+	r := &AuthorizerConfigurationResource{}
+	s := authorizerConfigurationSchema()
+	prior := authorizerValidateSettings(t, false, "validator-1")
+	state := configurationSettingsModel{
+		configurationModel: configurationModel{
+			ResourceID: types.StringValue("c1"),
+			Name:       types.StringValue("n"),
+			Values:     structuredSettingsJSON(context.Background(), prior),
+		},
+		Settings: prior,
+	}
+	plan := state
+	plan.Settings = authorizerValidateSettings(t, false, "")
+
+	req := resource.ModifyPlanRequest{Plan: configurationSettingsPlan(t, s, plan), State: configurationSettingsState(t, s, state)}
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(context.Background(), req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+
+	var got jsonString
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(context.Background(), path.Root("values"), &got)...)
+	if want := `{"policies":{"validate":{"skip":false}}}`; got.ValueString() != want {
+		t.Fatalf("expected values without the validator, got %s", got)
+	}
+}
@@ -23,3 +23,23 @@ func TestAPIClient_BaseURLs(t *testing.T) {
 		t.Fatalf("unexpected gateway url: %q", got)
 	}
 }
+
+func TestAPIClient_BaseURLs_EndpointOverrides(t *testing.T) {
+	c := &APIClient{cfg: providerConfig{
+		domain:        "example.com",
+		defaultRegion: "eu",
+		endpoints: map[string]string{
+			"iam":     "http://localhost:8080",
+			"gateway": "https://gateway.staging.example.net/mgmt",
+		},
+	}}
+	if got := c.iamBaseURL(); got != "http://localhost:8080" {
+		t.Fatalf("unexpected iam url: %q", got)
+	}
+	if got := c.gatewayBaseURL(); got != "https://gateway.staging.example.net/mgmt" {
+		t.Fatalf("unexpected gateway url: %q", got)
+	}
+	if got := c.resolverBaseURL(); got != "https://resolver.management.eu.example.com" {
+		t.Fatalf("expected services without override to use the domain, got %q", got)
+	}
+}
@@ -331,6 +331,46 @@ func TestDeleteInstance_EventuallyConsistentWaitsUntilNotFound(t *testing.T) {
 	}
 }
 
+func TestDeleteInstance_WaitBoundedByDeadlineNotAttemptCount(t *testing.T) {
+	stubInstanceSleep(t)
+	var gets int
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if r.Method == "DELETE" {
+			return httpResponse(204, nil, ""), nil
+		}
+		gets++
+		if gets <= instanceDeleteMaxAttempts+4 {
+			return httpResponse(200, nil, `{}`), nil
+		}
+		return httpResponse(404, nil, `{"code":"NotFound","message":"missing"}`), nil
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	diags := deleteInstance(ctx, c, "https://example.com", "rid")
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", diags)
+	}
+	if gets != instanceDeleteMaxAttempts+5 {
+		t.Fatalf("expected polling until not found, got %d polls", gets)
+	}
+}
+
+func TestDeleteInstance_WithoutDeadlineTimesOutAfterFallbackAttempts(t *testing.T) {
+	stubInstanceSleep(t)
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if r.Method == "DELETE" {
+			return httpResponse(204, nil, ""), nil
+		}
+		return httpResponse(200, nil, `{}`), nil
+	}))
+
+	diags := deleteInstance(context.Background(), c, "https://example.com", "rid")
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Timed out waiting for deletion" {
+		t.Fatalf("expected deletion timeout, got %#v", diags)
+	}
+}
+
 func TestDeleteInstance_DeleteErrorStopsEarly(t *testing.T) {
 	var calls int
 	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
@@ -405,7 +445,7 @@ func TestDeleteInstance_TimesOutWhenStillReadable(t *testing.T) {
 }
 
 func TestInstanceResource_ReadIntoState_Mapping(t *testing.T) {
-	r := &instanceResource{baseURL: func(*APIClient) string { return "https://example.com" }}
+	r := &instanceResource{baseURL: func(*APIClient, string) string { return "https://example.com" }}
 	r.client = newTestClient(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
 		if req.Method != "GET" {
 			return httpResponse(500, nil, "unexpected"), nil
@@ -430,4 +470,236 @@ func TestInstanceResource_ReadIntoState_Mapping(t *testing.T) {
 	if !state.InlineConfiguration.IsNull() {
 		t.Fatalf("expected inline_configuration null")
 	}
+	if !state.Status.IsNull() {
+		t.Fatalf("expected status null when not reported")
+	}
+}
+
+func stubInstanceSleep(t *testing.T) *int {
+	t.Helper()
+	var sleeps int
+	oldSleep := instanceSleepFn
+	oldNow := instanceNowFn
+	instanceSleepFn = func(time.Duration) { sleeps++ }
+	instanceNowFn = func() time.Time { return time.Unix(0, 0) }
+	t.Cleanup(func() {
+		instanceSleepFn = oldSleep
+		instanceNowFn = oldNow
+	})
+	return &sleeps
+}
+
+func TestSetInstanceStatus_HitsStatusEndpoint(t *testing.T) {
+	var gotBody string
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if r.Method != http.MethodPut || r.URL.String() != "https://example.com/instances/r%2Fid/status" {
+			return httpResponse(500, nil, "unexpected "+r.Method+" "+r.URL.String()), nil
+		}
+		b, _ := io.ReadAll(r.Body)
+		gotBody = string(b)
+		return httpResponse(204, nil, ""), nil
+	}))
+
+	diags := setInstanceStatus(context.Background(), c, "https://example.com", "r/id", "STOPPED")
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", diags)
+	}
+	if gotBody != `{"status":"STOPPED"}` {
+		t.Fatalf("unexpected body: %s", gotBody)
+	}
+}
+
+func TestWaitForInstanceStatus_PollsUntilConverged(t *testing.T) {
+	sleeps := stubInstanceSleep(t)
+	statuses := []string{"STOPPING", "STOPPING", "stopped"}
+	var calls int
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		status := statuses[calls]
+		calls++
+		return httpResponse(200, nil, `{"instance":{"resourceId":"rid","status":"`+status+`"}}`), nil
+	}))
+
+	diags := waitForInstanceStatus(context.Background(), c, "https://example.com", "rid", "STOPPED")
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", diags)
+	}
+	if calls != 3 || *sleeps != 2 {
+		t.Fatalf("expected 3 polls and 2 sleeps, got %d/%d", calls, *sleeps)
+	}
+}
+
+func TestWaitForInstanceStatus_AcceptsRunningAliases(t *testing.T) {
+	stubInstanceSleep(t)
+	statuses := []string{"STARTING", "ready"}
+	var calls int
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		status := statuses[calls]
+		calls++
+		return httpResponse(200, nil, `{"instance":{"resourceId":"rid","status":"`+status+`"}}`), nil
+	}))
+
+	if diags := waitForInstanceStatus(context.Background(), c, "https://example.com", "rid", "RUNNING"); diags.HasError() || calls != 2 {
+		t.Fatalf("expected READY to satisfy RUNNING after 2 polls, got %d polls: %#v", calls, diags)
+	}
+}
+
+func TestNormalizeInstanceStatus(t *testing.T) {
+	for in, want := range map[string]string{
+		"RUNNING":      "RUNNING",
+		"running":      "RUNNING",
+		"READY":        "RUNNING",
+		"Active":       "RUNNING",
+		"stopped":      "STOPPED",
+		"PROVISIONING": "PROVISIONING",
+	} {
+		if got := normalizeInstanceStatus(in); got != want {
+			t.Fatalf("normalizeInstanceStatus(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestWaitForInstanceStatus_FailedStatusStopsEarly(t *testing.T) {
+	stubInstanceSleep(t)
+	var calls int
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		return httpResponse(200, nil, `{"instance":{"resourceId":"rid","status":"FAILED"}}`), nil
+	}))
+
+	diags := waitForInstanceStatus(context.Background(), c, "https://example.com", "rid", "RUNNING")
+	if !diags.HasError() {
+		t.Fatalf("expected error diagnostics")
+	}
+	if diags.Errors()[0].Summary() != "Instance status transition failed" {
+		t.Fatalf("unexpected summary: %q", diags.Errors()[0].Summary())
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 poll, got %d", calls)
+	}
+}
+
+func TestWaitForInstanceStatus_NotFoundAndAPIError(t *testing.T) {
+	stubInstanceSleep(t)
+
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return httpResponse(404, nil, `{"code":"NotFound","message":"missing"}`), nil
+	}))
+	diags := waitForInstanceStatus(context.Background(), c, "https://example.com", "rid", "RUNNING")
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Instance not found" {
+		t.Fatalf("expected not found error, got %#v", diags)
+	}
+
+	c = newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return httpResponse(400, nil, `{"code":"Bad","message":"nope"}`), nil
+	}))
+	diags = waitForInstanceStatus(context.Background(), c, "https://example.com", "rid", "RUNNING")
+	if !diags.HasError() || diags.Errors()[0].Summary() != "API error" {
+		t.Fatalf("expected API error, got %#v", diags)
+	}
+}
+
+func TestWaitForInstanceStatus_TimesOut(t *testing.T) {
+	stubInstanceSleep(t)
+	var calls int
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		return httpResponse(200, nil, `{"instance":{"resourceId":"rid","status":"STARTING"}}`), nil
+	}))
+
+	diags := waitForInstanceStatus(context.Background(), c, "https://example.com", "rid", "RUNNING")
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Timed out waiting for instance status" {
+		t.Fatalf("expected timeout error, got %#v", diags)
+	}
+	if calls != instanceStatusMaxAttempts {
+		t.Fatalf("expected %d polls, got %d", instanceStatusMaxAttempts, calls)
+	}
+}
+
+func TestWaitForInstanceReady_PollsUntilEndpointAndReadyStatus(t *testing.T) {
+	sleeps := stubInstanceSleep(t)
+	bodies := []string{
+		`{"instance":{"resourceId":"rid","status":"PROVISIONING"}}`,
+		`{"instance":{"resourceId":"rid","status":"running"}}`,
+		`{"instance":{"resourceId":"rid","status":"RUNNING","endpoint":"https://e"}}`,
+	}
+	var calls int
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		body := bodies[calls]
+		calls++
+		return httpResponse(200, nil, body), nil
+	}))
+
+	diags := waitForInstanceReady(context.Background(), c, "https://example.com", "rid")
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", diags)
+	}
+	if calls != 3 || *sleeps != 2 {
+		t.Fatalf("expected 3 polls and 2 sleeps, got %d/%d", calls, *sleeps)
+	}
+}
+
+func TestWaitForInstanceReady_SettledWithoutWaiting(t *testing.T) {
+	stubInstanceSleep(t)
+	for _, body := range []string{
+		`{"instance":{"resourceId":"rid","status":"STOPPED"}}`,
+		`{"instance":{"resourceId":"rid","status":"SUSPENDED"}}`,
+		`{"instance":{"resourceId":"rid","endpoint":"https://e"}}`,
+	} {
+		var calls int
+		c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			calls++
+			return httpResponse(200, nil, body), nil
+		}))
+
+		diags := waitForInstanceReady(context.Background(), c, "https://example.com", "rid")
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics for %s: %#v", body, diags)
+		}
+		if calls != 1 {
+			t.Fatalf("expected 1 poll for %s, got %d", body, calls)
+		}
+	}
+}
+
+func TestWaitForInstanceReady_FailedAndNotFound(t *testing.T) {
+	stubInstanceSleep(t)
+
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return httpResponse(200, nil, `{"instance":{"resourceId":"rid","status":"ERROR"}}`), nil
+	}))
+	diags := waitForInstanceReady(context.Background(), c, "https://example.com", "rid")
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Instance failed to become ready" {
+		t.Fatalf("expected failed error, got %#v", diags)
+	}
+
+	c = newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return httpResponse(404, nil, `{"code":"NotFound","message":"missing"}`), nil
+	}))
+	diags = waitForInstanceReady(context.Background(), c, "https://example.com", "rid")
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Instance not found" {
+		t.Fatalf("expected not found error, got %#v", diags)
+	}
+}
+
+func TestWaitForInstanceReady_BoundedByContext(t *testing.T) {
+	stubInstanceSleep(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls int
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		if calls == 3 {
+			cancel()
+		}
+		return httpResponse(200, nil, `{"instance":{"resourceId":"rid","status":"PROVISIONING"}}`), nil
+	}))
+
+	diags := waitForInstanceReady(ctx, c, "https://example.com", "rid")
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Timed out waiting for instance to become ready" {
+		t.Fatalf("expected timeout error, got %#v", diags)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 polls, got %d", calls)
+	}
 }
@@ -6,14 +6,24 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type configurationModel struct {
-	ResourceID types.String `tfsdk:"resource_id"`
-	Name       types.String `tfsdk:"name"`
-	Values     types.String `tfsdk:"values"`
+	ResourceID types.String   `tfsdk:"resource_id"`
+	Name       types.String   `tfsdk:"name"`
+	Values     jsonString     `tfsdk:"values"`
+	Region     types.String   `tfsdk:"region"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+// configurationSettingsModel is the configuration model for services whose values can also be
+// written as typed settings.
+type configurationSettingsModel struct {
+	configurationModel
+	Settings types.Object `tfsdk:"settings"`
 }
 
 type configurationReadResponse struct {
@@ -24,6 +34,23 @@ type configurationReadResponse struct {
 	} `json:"configuration"`
 }
 
+type configurationListItem struct {
+	ResourceID string `json:"resourceId"`
+	Name       string `json:"name"`
+}
+
+func listConfigurations(ctx context.Context, client *APIClient, baseURL string) ([]configurationListItem, diag.Diagnostics) {
+	return listAllPages[configurationListItem](ctx, client, baseURL, "/configurations", "configurations")
+}
+
+// configurationResponseToModel maps a read response onto the shared configuration model used
+// by both the configuration resources and the configuration data sources.
+func configurationResponseToModel(out configurationReadResponse, valuesJSON string, state *configurationModel) {
+	state.ResourceID = types.StringValue(out.Configuration.ResourceID)
+	state.Name = types.StringValue(out.Configuration.Name)
+	state.Values = jsonStringValue(valuesJSON)
+}
+
 func configurationCreatePayload(resourceID, name string, values any) map[string]any {
 	return map[string]any{
 		"configurationResourceId": resourceID,
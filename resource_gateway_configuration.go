@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type GatewayConfigurationResource struct {
@@ -23,12 +22,14 @@ func NewGatewayConfigurationResource() resource.Resource {
 var _ resource.Resource = (*GatewayConfigurationResource)(nil)
 var _ resource.ResourceWithConfigure = (*GatewayConfigurationResource)(nil)
 var _ resource.ResourceWithImportState = (*GatewayConfigurationResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*GatewayConfigurationResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*GatewayConfigurationResource)(nil)
 
 func (r *GatewayConfigurationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_gateway_configuration"
 }
 
-func (r *GatewayConfigurationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *GatewayConfigurationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"resource_id": schema.StringAttribute{
@@ -45,9 +46,16 @@ func (r *GatewayConfigurationResource) Schema(_ context.Context, _ resource.Sche
 				Description: "Human readable configuration name.",
 			},
 			"values": schema.StringAttribute{
-				Required:    true,
-				Description: "Gateway configuration values JSON (string).",
+				CustomType:  jsonStringType{},
+				Optional:    true,
+				Computed:    true,
+				Description: "Gateway configuration values JSON (string). Exactly one of values or settings must be set; when settings is used this is computed from it.",
 			},
+			"settings": gatewaySettingsSchemaAttribute("Typed gateway configuration, validated at plan time. Alternative to values."),
+			"region":   regionSchemaAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -59,23 +67,38 @@ func (r *GatewayConfigurationResource) Configure(_ context.Context, req resource
 	r.client = req.ProviderData.(*APIClient)
 }
 
+func (r *GatewayConfigurationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateSettingsConfig(ctx, req.Config, &resp.Diagnostics, "values", "settings", true)
+}
+
+func (r *GatewayConfigurationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planJSONFromSettings(ctx, req, resp, "values", "settings")
+}
+
 func (r *GatewayConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var config configurationModel
+	var config configurationSettingsModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var plan configurationModel
+	var plan configurationSettingsModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, plan.Timeouts.Create, defaultCreateTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resourceID, ok := resolveOrGenerateResourceID(&resp.Diagnostics, config.ResourceID, path.Root("resource_id"))
 	if !ok {
 		return
 	}
+	plan.Region = r.client.resolveRegion(plan.Region)
 
 	values := parseJSONToAny(&resp.Diagnostics, plan.Values.ValueString(), path.Root("values"), "values")
 	if resp.Diagnostics.HasError() {
@@ -83,7 +106,7 @@ func (r *GatewayConfigurationResource) Create(ctx context.Context, req resource.
 	}
 
 	payload := configurationCreatePayload(resourceID, plan.Name.ValueString(), values)
-	resp.Diagnostics.Append(createConfiguration(ctx, r.client, r.client.gatewayBaseURL(), payload)...)
+	resp.Diagnostics.Append(createConfiguration(ctx, r.client, r.client.regionalBaseURL("gateway", plan.Region.ValueString()), payload)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -97,12 +120,20 @@ func (r *GatewayConfigurationResource) Create(ctx context.Context, req resource.
 }
 
 func (r *GatewayConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state configurationModel
+	var state configurationSettingsModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, state.Timeouts.Read, defaultReadTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// State written before region existed, or imported without one, is in the provider region.
+	state.Region = r.client.resolveRegion(state.Region)
 	resourceID := state.ResourceID.ValueString()
 	found, diags := r.readIntoState(ctx, resourceID, &state)
 	resp.Diagnostics.Append(diags...)
@@ -117,19 +148,26 @@ func (r *GatewayConfigurationResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *GatewayConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan configurationModel
+	var plan configurationSettingsModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, plan.Timeouts.Update, defaultUpdateTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resourceID := plan.ResourceID.ValueString()
+	plan.Region = r.client.resolveRegion(plan.Region)
 	values := parseJSONToAny(&resp.Diagnostics, plan.Values.ValueString(), path.Root("values"), "values")
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	payload := configurationUpdatePayload(plan.Name.ValueString(), values)
-	resp.Diagnostics.Append(updateConfiguration(ctx, r.client, r.client.gatewayBaseURL(), resourceID, payload)...)
+	resp.Diagnostics.Append(updateConfiguration(ctx, r.client, r.client.regionalBaseURL("gateway", plan.Region.ValueString()), resourceID, payload)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -143,22 +181,28 @@ func (r *GatewayConfigurationResource) Update(ctx context.Context, req resource.
 }
 
 func (r *GatewayConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state configurationModel
+	var state configurationSettingsModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, state.Timeouts.Delete, defaultDeleteTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resourceID := state.ResourceID.ValueString()
-	resp.Diagnostics.Append(deleteConfiguration(ctx, r.client, r.client.gatewayBaseURL(), resourceID)...)
+	resp.Diagnostics.Append(deleteConfiguration(ctx, r.client, r.client.regionalBaseURL("gateway", state.Region.ValueString()), resourceID)...)
 }
 
 func (r *GatewayConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("resource_id"), req, resp)
+	importRegionalState(ctx, req, resp)
 }
 
-func (r *GatewayConfigurationResource) readIntoState(ctx context.Context, resourceID string, state *configurationModel) (bool, diag.Diagnostics) {
-	found, out, valuesJSON, diags := readConfigurationIntoState(ctx, r.client, r.client.gatewayBaseURL(), resourceID)
+func (r *GatewayConfigurationResource) readIntoState(ctx context.Context, resourceID string, state *configurationSettingsModel) (bool, diag.Diagnostics) {
+	found, out, valuesJSON, diags := readConfigurationIntoState(ctx, r.client, r.client.regionalBaseURL("gateway", state.Region.ValueString()), resourceID)
 	if diags.HasError() {
 		return false, diags
 	}
@@ -166,9 +210,7 @@ func (r *GatewayConfigurationResource) readIntoState(ctx context.Context, resour
 		return false, diags
 	}
 
-	state.ResourceID = types.StringValue(out.Configuration.ResourceID)
-	state.Name = types.StringValue(out.Configuration.Name)
-	state.Values = types.StringValue(valuesJSON)
+	configurationResponseToModel(out, valuesJSON, &state.configurationModel)
 
 	return true, diags
 }
@@ -60,23 +60,23 @@ func TestGatewayConfigurationResource_Create_SendsExpectedPayload(t *testing.T)
 
 	r := &GatewayConfigurationResource{client: c}
 
-	planModel := configurationModel{
+	planModel := configurationSettingsModel{configurationModel: configurationModel{
 		ResourceID: types.StringNull(),
 		Name:       types.StringValue("n"),
-		Values:     types.StringValue(`{"a":1}`),
-	}
-	configModel := configurationModel{
+		Values:     jsonStringValue(`{"a":1}`),
+	}}
+	configModel := configurationSettingsModel{configurationModel: configurationModel{
 		ResourceID: types.StringNull(),
 		Name:       types.StringValue("n"),
-		Values:     types.StringValue(`{"a":1}`),
-	}
+		Values:     jsonStringValue(`{"a":1}`),
+	}}
 
 	var req resource.CreateRequest
-	req.Plan = configurationPlan(t, planModel)
-	req.Config = configurationConfig(t, configModel)
+	req.Plan = configurationSettingsPlan(t, gatewayConfigurationSchema(), planModel)
+	req.Config = configurationSettingsConfig(t, gatewayConfigurationSchema(), configModel)
 
 	var resp resource.CreateResponse
-	initConfigurationState(t, &resp.State)
+	initSchemaState(t, &resp.State, gatewayConfigurationSchema())
 
 	oldRead := cryptoRandRead
 	cryptoRandRead = func(b []byte) (int, error) {
@@ -112,23 +112,23 @@ func TestGatewayConfigurationResource_Create_InvalidValuesJSONAddsDiagnostics(t
 
 	r := &GatewayConfigurationResource{client: c}
 
-	planModel := configurationModel{
+	planModel := configurationSettingsModel{configurationModel: configurationModel{
 		ResourceID: types.StringNull(),
 		Name:       types.StringValue("n"),
-		Values:     types.StringValue(`{bad json}`),
-	}
-	configModel := configurationModel{
+		Values:     jsonStringValue(`{bad json}`),
+	}}
+	configModel := configurationSettingsModel{configurationModel: configurationModel{
 		ResourceID: types.StringNull(),
 		Name:       types.StringValue("n"),
-		Values:     types.StringValue(`{bad json}`),
-	}
+		Values:     jsonStringValue(`{bad json}`),
+	}}
 
 	var req resource.CreateRequest
-	req.Plan = configurationPlan(t, planModel)
-	req.Config = configurationConfig(t, configModel)
+	req.Plan = configurationSettingsPlan(t, gatewayConfigurationSchema(), planModel)
+	req.Config = configurationSettingsConfig(t, gatewayConfigurationSchema(), configModel)
 
 	var resp resource.CreateResponse
-	initConfigurationState(t, &resp.State)
+	initSchemaState(t, &resp.State, gatewayConfigurationSchema())
 
 	r.Create(context.Background(), req, &resp)
 	if !resp.Diagnostics.HasError() {
@@ -145,17 +145,17 @@ func TestGatewayConfigurationResource_Read_NotFoundRemovesResource(t *testing.T)
 	}))
 
 	r := &GatewayConfigurationResource{client: c}
-	stateModel := configurationModel{
+	stateModel := configurationSettingsModel{configurationModel: configurationModel{
 		ResourceID: types.StringValue("rid"),
 		Name:       types.StringValue("n"),
-		Values:     types.StringValue(`{"a":1}`),
-	}
+		Values:     jsonStringValue(`{"a":1}`),
+	}}
 
 	var req resource.ReadRequest
-	req.State = configurationState(t, stateModel)
+	req.State = configurationSettingsState(t, gatewayConfigurationSchema(), stateModel)
 
 	var resp resource.ReadResponse
-	initConfigurationState(t, &resp.State)
+	initSchemaState(t, &resp.State, gatewayConfigurationSchema())
 
 	r.Read(context.Background(), req, &resp)
 	if resp.Diagnostics.HasError() {
@@ -188,17 +188,17 @@ func TestGatewayConfigurationResource_Update_SendsExpectedPayload(t *testing.T)
 
 	r := &GatewayConfigurationResource{client: c}
 
-	planModel := configurationModel{
+	planModel := configurationSettingsModel{configurationModel: configurationModel{
 		ResourceID: types.StringValue("rid"),
 		Name:       types.StringValue("n2"),
-		Values:     types.StringValue(`{"b":2}`),
-	}
+		Values:     jsonStringValue(`{"b":2}`),
+	}}
 
 	var req resource.UpdateRequest
-	req.Plan = configurationPlan(t, planModel)
+	req.Plan = configurationSettingsPlan(t, gatewayConfigurationSchema(), planModel)
 
 	var resp resource.UpdateResponse
-	initConfigurationState(t, &resp.State)
+	initSchemaState(t, &resp.State, gatewayConfigurationSchema())
 
 	r.Update(context.Background(), req, &resp)
 	if resp.Diagnostics.HasError() {
@@ -230,10 +230,10 @@ func TestGatewayConfigurationResource_Delete_CallsDeleteConfiguration(t *testing
 	}))
 
 	r := &GatewayConfigurationResource{client: c}
-	stateModel := configurationModel{ResourceID: types.StringValue("rid")}
+	stateModel := configurationSettingsModel{configurationModel: configurationModel{ResourceID: types.StringValue("rid")}}
 
 	var req resource.DeleteRequest
-	req.State = configurationState(t, stateModel)
+	req.State = configurationSettingsState(t, gatewayConfigurationSchema(), stateModel)
 
 	var resp resource.DeleteResponse
 	r.Delete(context.Background(), req, &resp)
@@ -248,7 +248,7 @@ func TestGatewayConfigurationResource_Delete_CallsDeleteConfiguration(t *testing
 func TestGatewayConfigurationResource_ImportState_Passthrough(t *testing.T) {
 	r := &GatewayConfigurationResource{}
 	var resp resource.ImportStateResponse
-	initConfigurationState(t, &resp.State)
+	initSchemaState(t, &resp.State, gatewayConfigurationSchema())
 
 	r.ImportState(context.Background(), resource.ImportStateRequest{ID: "rid"}, &resp)
 	if resp.Diagnostics.HasError() {
@@ -264,3 +264,120 @@ func TestGatewayConfigurationResource_ImportState_Passthrough(t *testing.T) {
 		t.Fatalf("unexpected imported id: %q", got)
 	}
 }
+
+func TestGatewayConfigurationResource_ValidateConfig_RequiresExactlyOneForm(t *testing.T) {
+	r := &GatewayConfigurationResource{}
+
+	tests := []struct {
+		name     string
+		values   jsonString
+		settings types.Object
+		wantErr  bool
+	}{
+		{"values", jsonStringValue(`{"a":1}`), gatewaySettingsNull(), false},
+		{"settings", jsonStringNull(), authorizerGatewaySettings(t), false},
+		{"both", jsonStringValue(`{"a":1}`), authorizerGatewaySettings(t), true},
+		{"neither", jsonStringNull(), gatewaySettingsNull(), true},
+	}
+	for _, tc := range tests {
+		model := configurationSettingsModel{
+			configurationModel: configurationModel{Name: types.StringValue("n"), Values: tc.values},
+			Settings:           tc.settings,
+		}
+		var resp resource.ValidateConfigResponse
+		r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: configurationSettingsConfig(t, gatewayConfigurationSchema(), model)}, &resp)
+		if resp.Diagnostics.HasError() != tc.wantErr {
+			t.Fatalf("%s: expected error=%v, got %#v", tc.name, tc.wantErr, resp.Diagnostics)
+		}
+	}
+}
+
+func TestGatewayConfigurationResource_ModifyPlan_RendersValuesFromSettings(t *testing.T) {
+	r := &GatewayConfigurationResource{}
+	model := configurationSettingsModel{
+		configurationModel: configurationModel{
+			ResourceID: types.StringUnknown(),
+			Name:       types.StringValue("n"),
+			Values:     jsonStringUnknown(),
+		},
+		Settings: authorizerGatewaySettings(t),
+	}
+
+	req := resource.ModifyPlanRequest{Plan: configurationSettingsPlan(t, gatewayConfigurationSchema(), model)}
+	initSchemaState(t, &req.State, gatewayConfigurationSchema())
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+
+	r.ModifyPlan(context.Background(), req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+
+	var got jsonString
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(context.Background(), path.Root("values"), &got)...)
+	if got.IsUnknown() || !jsonSemanticallyContains(authorizerGatewayJSON, got.ValueString()) {
+		t.Fatalf("expected values rendered from settings, got %s", got)
+	}
+}
+
+func TestGatewayConfigurationResource_Create_WithSettingsSendsRenderedValues(t *testing.T) {
+	var gotBody string
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		switch r.Method {
+		case http.MethodPost:
+			b, _ := io.ReadAll(r.Body)
+			gotBody = string(b)
+			return httpResponse(200, nil, `{}`), nil
+		case http.MethodGet:
+			return httpResponse(200, nil, `{"configuration":{"resourceId":"rid","name":"n","values":`+authorizerGatewayJSON+`}}`), nil
+		default:
+			return httpResponse(500, nil, "unexpected"), nil
+		}
+	}))
+	r := &GatewayConfigurationResource{client: c}
+
+	settings := authorizerGatewaySettings(t)
+	model := configurationSettingsModel{
+		configurationModel: configurationModel{
+			ResourceID: types.StringValue("rid"),
+			Name:       types.StringValue("n"),
+			Values:     structuredSettingsJSON(context.Background(), settings),
+		},
+		Settings: settings,
+	}
+
+	var req resource.CreateRequest
+	req.Plan = configurationSettingsPlan(t, gatewayConfigurationSchema(), model)
+	req.Config = configurationSettingsConfig(t, gatewayConfigurationSchema(), configurationSettingsModel{
+		configurationModel: configurationModel{ResourceID: types.StringValue("rid"), Name: types.StringValue("n")},
+		Settings:           settings,
+	})
+
+	var resp resource.CreateResponse
+	initSchemaState(t, &resp.State, gatewayConfigurationSchema())
+
+	r.Create(context.Background(), req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+
+	var payload struct {
+		Configuration struct {
+			Values json.RawMessage `json:"values"`
+		} `json:"configuration"`
+	}
+	if err := json.Unmarshal([]byte(gotBody), &payload); err != nil {
+		t.Fatalf("invalid json payload: %s", err)
+	}
+	if !jsonSemanticallyContains(authorizerGatewayJSON, string(payload.Configuration.Values)) {
+		t.Fatalf("expected rendered settings in payload, got: %s", gotBody)
+	}
+
+	var state configurationSettingsModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+	if state.Settings.IsNull() {
+		t.Fatalf("expected settings kept in state")
+	}
+}
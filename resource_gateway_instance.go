@@ -10,14 +10,16 @@ import (
 )
 
 type GatewayInstanceResource struct {
-	instanceResource
+	instanceSettingsResource
 }
 
 func NewGatewayInstanceResource() resource.Resource {
 	return &GatewayInstanceResource{
-		instanceResource: instanceResource{
-			baseURL: func(client *APIClient) string {
-				return client.gatewayBaseURL()
+		instanceSettingsResource: instanceSettingsResource{
+			instanceResource: instanceResource{
+				baseURL: func(client *APIClient, region string) string {
+					return client.regionalBaseURL("gateway", region)
+				},
 			},
 		},
 	}
@@ -26,12 +28,14 @@ func NewGatewayInstanceResource() resource.Resource {
 var _ resource.Resource = (*GatewayInstanceResource)(nil)
 var _ resource.ResourceWithConfigure = (*GatewayInstanceResource)(nil)
 var _ resource.ResourceWithImportState = (*GatewayInstanceResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*GatewayInstanceResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*GatewayInstanceResource)(nil)
 
 func (r *GatewayInstanceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_gateway_instance"
 }
 
-func (r *GatewayInstanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *GatewayInstanceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"resource_id": schema.StringAttribute{
@@ -52,14 +56,21 @@ func (r *GatewayInstanceResource) Schema(_ context.Context, _ resource.SchemaReq
 				Description: "Gateway configuration resource ID to apply to this instance.",
 			},
 			"inline_configuration": schema.StringAttribute{
+				CustomType:  jsonStringType{},
 				Optional:    true,
 				Computed:    true,
-				Description: "Inline gateway configuration JSON (string). If omitted, the server may default this to an empty object.",
+				Description: "Inline gateway configuration JSON (string). If omitted, the server may default this to an empty object. Conflicts with inline_settings; computed from it when that is used.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
-			"endpoint": instanceEndpointSchemaAttribute(),
+			"inline_settings": gatewaySettingsSchemaAttribute("Typed inline gateway configuration, validated at plan time. Alternative to inline_configuration."),
+			"endpoint":        instanceEndpointSchemaAttribute(),
+			"status":          instanceStatusSchemaAttribute(),
+			"region":          regionSchemaAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGatewayInstanceResource_ValidateConfig_InlineFormsConflict(t *testing.T) {
+	r := NewGatewayInstanceResource().(*GatewayInstanceResource)
+
+	tests := []struct {
+		name     string
+		inline   jsonString
+		settings types.Object
+		wantErr  bool
+	}{
+		{"neither", jsonStringNull(), gatewaySettingsNull(), false},
+		{"inline_configuration", jsonStringValue(`{}`), gatewaySettingsNull(), false},
+		{"inline_settings", jsonStringNull(), authorizerGatewaySettings(t), false},
+		{"both", jsonStringValue(`{}`), authorizerGatewaySettings(t), true},
+	}
+	for _, tc := range tests {
+		model := instanceSettingsModel{
+			instanceModel:  instanceModel{Name: types.StringValue("n"), InlineConfiguration: tc.inline},
+			InlineSettings: tc.settings,
+		}
+		var resp resource.ValidateConfigResponse
+		r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: instanceSettingsConfig(t, gatewayInstanceSchema(), model)}, &resp)
+		if resp.Diagnostics.HasError() != tc.wantErr {
+			t.Fatalf("%s: expected error=%v, got %#v", tc.name, tc.wantErr, resp.Diagnostics)
+		}
+	}
+}
+
+func TestGatewayInstanceResource_ModifyPlan_KeepsPriorWhenInSync(t *testing.T) {
+	r := NewGatewayInstanceResource().(*GatewayInstanceResource)
+	settings := authorizerGatewaySettings(t)
+	// Server-side defaults on top of the rendered document must not produce a diff.
+	prior := jsonStringValue(`{"cors":{"enabled":true,"origin":["*"],"allowHeaders":["*"],"maxAge":600},"paths":{"auth":{"type":"instance","service":"authorizer","resourceId":"auth-1","serviceRole":{"owner":"managed","resourceId":"authorizer_all_actions"}}}}`)
+
+	state := instanceSettingsModel{
+		instanceModel: instanceModel{
+			ResourceID:          types.StringValue("rid"),
+			Name:                types.StringValue("n"),
+			InlineConfiguration: prior,
+			Endpoint:            types.StringValue("https://e"),
+			Status:              types.StringValue("RUNNING"),
+		},
+		InlineSettings: settings,
+	}
+	plan := state
+	plan.InlineConfiguration = jsonStringUnknown()
+
+	req := resource.ModifyPlanRequest{Plan: instanceSettingsPlan(t, gatewayInstanceSchema(), plan), State: instanceSettingsState(t, gatewayInstanceSchema(), state)}
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+
+	r.ModifyPlan(context.Background(), req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+
+	var got jsonString
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(context.Background(), path.Root("inline_configuration"), &got)...)
+	if got.ValueString() != prior.ValueString() {
+		t.Fatalf("expected prior inline_configuration kept, got %s", got)
+	}
+}
+
+func TestGatewayInstanceResource_Create_WithInlineSettings(t *testing.T) {
+	var gotBody string
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		switch r.Method {
+		case http.MethodPost:
+			b, _ := io.ReadAll(r.Body)
+			gotBody = string(b)
+			return httpResponse(200, nil, `{}`), nil
+		case http.MethodGet:
+			return httpResponse(200, nil, `{"instance":{"resourceId":"rid","name":"n","inlineConfiguration":`+authorizerGatewayJSON+`,"endpoint":"https://e","status":"RUNNING"}}`), nil
+		default:
+			return httpResponse(500, nil, "unexpected"), nil
+		}
+	}))
+	r := NewGatewayInstanceResource().(*GatewayInstanceResource)
+	r.client = c
+
+	settings := authorizerGatewaySettings(t)
+	plan := instanceSettingsModel{
+		instanceModel: instanceModel{
+			ResourceID:          types.StringValue("rid"),
+			Name:                types.StringValue("n"),
+			InlineConfiguration: structuredSettingsJSON(context.Background(), settings),
+			Endpoint:            types.StringUnknown(),
+			Status:              types.StringUnknown(),
+		},
+		InlineSettings: settings,
+	}
+	config := instanceSettingsModel{
+		instanceModel:  instanceModel{ResourceID: types.StringValue("rid"), Name: types.StringValue("n")},
+		InlineSettings: settings,
+	}
+
+	var resp resource.CreateResponse
+	initSchemaState(t, &resp.State, gatewayInstanceSchema())
+	r.Create(context.Background(), resource.CreateRequest{Config: instanceSettingsConfig(t, gatewayInstanceSchema(), config), Plan: instanceSettingsPlan(t, gatewayInstanceSchema(), plan)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+
+	var payload struct {
+		Instance struct {
+			InlineConfiguration json.RawMessage `json:"inlineConfiguration"`
+		} `json:"instance"`
+	}
+	if err := json.Unmarshal([]byte(gotBody), &payload); err != nil {
+		t.Fatalf("invalid json payload: %s", err)
+	}
+	if !jsonSemanticallyContains(authorizerGatewayJSON, string(payload.Instance.InlineConfiguration)) {
+		t.Fatalf("expected rendered inline settings in payload, got: %s", gotBody)
+	}
+
+	var state instanceSettingsModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+	if state.InlineSettings.IsNull() || state.Endpoint.ValueString() != "https://e" {
+		t.Fatalf("unexpected state: %#v", state)
+	}
+}
+
+func TestGatewayInstanceResource_ReadAndDelete_UseEmbeddedModel(t *testing.T) {
+	var deletes int
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		switch r.Method {
+		case http.MethodGet:
+			if deletes > 0 {
+				return httpResponse(404, nil, `{"code":"NotFound","message":"missing"}`), nil
+			}
+			return httpResponse(200, nil, `{"instance":{"resourceId":"rid","name":"renamed","inlineConfiguration":{},"endpoint":"https://e","status":"RUNNING"}}`), nil
+		case http.MethodDelete:
+			deletes++
+			return httpResponse(204, nil, ""), nil
+		default:
+			return httpResponse(500, nil, "unexpected"), nil
+		}
+	}))
+	stubInstanceSleep(t)
+	r := NewGatewayInstanceResource().(*GatewayInstanceResource)
+	r.client = c
+
+	stateModel := instanceSettingsModel{instanceModel: instanceModel{ResourceID: types.StringValue("rid"), Name: types.StringValue("n")}}
+
+	var readResp resource.ReadResponse
+	initSchemaState(t, &readResp.State, gatewayInstanceSchema())
+	r.Read(context.Background(), resource.ReadRequest{State: instanceSettingsState(t, gatewayInstanceSchema(), stateModel)}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", readResp.Diagnostics)
+	}
+	var name types.String
+	readResp.Diagnostics.Append(readResp.State.GetAttribute(context.Background(), path.Root("name"), &name)...)
+	if name.ValueString() != "renamed" {
+		t.Fatalf("expected refreshed name, got %s", name)
+	}
+
+	var deleteResp resource.DeleteResponse
+	r.Delete(context.Background(), resource.DeleteRequest{State: instanceSettingsState(t, gatewayInstanceSchema(), stateModel)}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", deleteResp.Diagnostics)
+	}
+	if deletes != 1 {
+		t.Fatalf("expected one DELETE, got %d", deletes)
+	}
+}
@@ -5,13 +5,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -19,11 +22,21 @@ type IamApiKeyResource struct {
 	client *APIClient
 }
 
+// apiKeyNowFn exists to make rotation schedules testable.
+// Production code uses time.Now.
+var apiKeyNowFn = time.Now
+
 type iamApiKeyModel struct {
-	ResourceID           types.String `tfsdk:"resource_id"`
-	Name                 types.String `tfsdk:"name"`
-	InlinePolicyDocument types.String `tfsdk:"inline_policy_document"`
-	ApiSecret            types.String `tfsdk:"api_secret"`
+	ResourceID           types.String   `tfsdk:"resource_id"`
+	Name                 types.String   `tfsdk:"name"`
+	InlinePolicyDocument jsonString     `tfsdk:"inline_policy_document"`
+	ApiSecret            types.String   `tfsdk:"api_secret"`
+	RotationTriggers     types.Map      `tfsdk:"rotation_triggers"`
+	RotateAfter          types.String   `tfsdk:"rotate_after"`
+	RotationGracePeriod  types.String   `tfsdk:"rotation_grace_period"`
+	RotatedAt            types.String   `tfsdk:"rotated_at"`
+	RotationDue          types.Bool     `tfsdk:"rotation_due"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewIamApiKeyResource() resource.Resource {
@@ -33,12 +46,13 @@ func NewIamApiKeyResource() resource.Resource {
 var _ resource.Resource = (*IamApiKeyResource)(nil)
 var _ resource.ResourceWithConfigure = (*IamApiKeyResource)(nil)
 var _ resource.ResourceWithImportState = (*IamApiKeyResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*IamApiKeyResource)(nil)
 
 func (r *IamApiKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_iam_api_key"
 }
 
-func (r *IamApiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IamApiKeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"resource_id": schema.StringAttribute{
@@ -53,15 +67,43 @@ func (r *IamApiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "Human readable API key name.",
 			},
 			"inline_policy_document": schema.StringAttribute{
+				CustomType:  jsonStringType{},
 				Optional:    true,
 				Description: "Inline policy document JSON (string) for this API key.",
+				Validators:  []validator.String{policyDocumentValidator{}},
 			},
 			"api_secret": schema.StringAttribute{
 				Computed:      true,
 				Sensitive:     true,
-				Description:   "API key secret (write-only). Returned only on create; not retrievable and will remain unknown after import.",
+				Description:   "API key secret (write-only). Returned only on create and rotation; not retrievable and will remain unknown after import until the key is rotated.",
 				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
+			"rotation_triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Arbitrary values that rotate the secret in place when changed. The key ID and its policy attachments are kept.",
+			},
+			"rotate_after": schema.StringAttribute{
+				Optional:    true,
+				Description: "Rotate the secret in place once it is older than this duration (e.g. 90d or 2160h). Checked when the key is refreshed.",
+				Validators:  []validator.String{durationValidator{}},
+			},
+			"rotation_grace_period": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long the previous secret stays valid after a rotation (e.g. 24h). When unset it is revoked immediately.",
+				Validators:  []validator.String{durationValidator{}},
+			},
+			"rotated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "RFC 3339 timestamp of when the current secret was issued. Null after import.",
+			},
+			"rotation_due": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the secret was older than rotate_after when the key was last refreshed. The next apply rotates it.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -80,6 +122,12 @@ func (r *IamApiKeyResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, plan.Timeouts.Create, defaultCreateTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	apiKey := map[string]any{
 		"name": plan.Name.ValueString(),
 	}
@@ -90,19 +138,8 @@ func (r *IamApiKeyResource) Create(ctx context.Context, req resource.CreateReque
 		}
 	}
 
-	payload := map[string]any{"apiKey": apiKey}
-
-	type apiKeyResponse struct {
-		ApiKey struct {
-			ResourceID           string `json:"resourceId"`
-			Name                 string `json:"name"`
-			InlinePolicyDocument any    `json:"inlinePolicyDocument"`
-			ApiSecret            string `json:"apiSecret"`
-		} `json:"apiKey"`
-	}
-
-	var out apiKeyResponse
-	resp.Diagnostics.Append(r.client.doJSON(ctx, "POST", joinURL(r.client.iamBaseURL(), "/api-keys"), payload, &out)...)
+	out, diags := createApiKey(ctx, r.client, apiKey)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -110,20 +147,22 @@ func (r *IamApiKeyResource) Create(ctx context.Context, req resource.CreateReque
 	plan.ResourceID = types.StringValue(out.ApiKey.ResourceID)
 	plan.Name = types.StringValue(out.ApiKey.Name)
 	if out.ApiKey.InlinePolicyDocument == nil {
-		plan.InlinePolicyDocument = types.StringNull()
+		plan.InlinePolicyDocument = jsonStringNull()
 	} else {
 		b, err := json.Marshal(out.ApiKey.InlinePolicyDocument)
 		if err != nil {
 			resp.Diagnostics.AddError("inlinePolicyDocument encode error", err.Error())
 			return
 		}
-		plan.InlinePolicyDocument = types.StringValue(string(b))
+		plan.InlinePolicyDocument = jsonStringValue(string(b))
 	}
 	if out.ApiKey.ApiSecret == "" {
 		plan.ApiSecret = types.StringUnknown()
 	} else {
 		plan.ApiSecret = types.StringValue(out.ApiKey.ApiSecret)
 	}
+	plan.RotatedAt = types.StringValue(apiKeyNowFn().UTC().Format(time.RFC3339))
+	plan.RotationDue = types.BoolValue(false)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -135,6 +174,12 @@ func (r *IamApiKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, state.Timeouts.Read, defaultReadTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	existingSecret := state.ApiSecret
 
 	resourceID := state.ResourceID.ValueString()
@@ -149,6 +194,9 @@ func (r *IamApiKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	state.ApiSecret = existingSecret
+	// Expiry is recorded here rather than checked in ModifyPlan so that a saved plan and its
+	// apply agree on whether the secret rotates.
+	state.RotationDue = types.BoolValue(apiKeyRotationExpired(state, apiKeyNowFn()))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -159,6 +207,12 @@ func (r *IamApiKeyResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, plan.Timeouts.Update, defaultUpdateTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resourceID, ok := requireKnownString(&resp.Diagnostics, plan.ResourceID, path.Root("resource_id"), "resource_id")
 	if !ok {
 		return
@@ -182,6 +236,32 @@ func (r *IamApiKeyResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	// ModifyPlan leaves rotated_at unknown exactly when the secret is due for rotation.
+	if plan.RotatedAt.IsUnknown() {
+		var grace time.Duration
+		if !plan.RotationGracePeriod.IsNull() && !plan.RotationGracePeriod.IsUnknown() {
+			d, err := parseDuration(plan.RotationGracePeriod.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("rotation_grace_period"), "Invalid duration", err.Error())
+				return
+			}
+			grace = d
+		}
+
+		out, diags := rotateApiKey(ctx, r.client, resourceID, grace)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if out.ApiKey.ApiSecret == "" {
+			resp.Diagnostics.AddError("API key secret missing", "The API did not return a secret for the rotated API key.")
+			return
+		}
+		plan.ApiSecret = types.StringValue(out.ApiKey.ApiSecret)
+		plan.RotatedAt = types.StringValue(apiKeyNowFn().UTC().Format(time.RFC3339))
+		plan.RotationDue = types.BoolValue(false)
+	}
+
 	existingSecret := plan.ApiSecret
 	found, diags := r.readIntoState(ctx, resourceID, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -197,6 +277,66 @@ func (r *IamApiKeyResource) Update(ctx context.Context, req resource.UpdateReque
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// ModifyPlan schedules an in-place secret rotation when rotation_triggers change or Read found the
+// current secret older than rotate_after. A due rotation is planned as unknown api_secret and
+// rotated_at; otherwise both keep their prior values, even when null after import.
+func (r *IamApiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state iamApiKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !apiKeyRotationDue(plan, state) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("api_secret"), state.ApiSecret)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rotated_at"), state.RotatedAt)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rotation_due"), state.RotationDue)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("api_secret"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rotated_at"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rotation_due"), types.BoolValue(false))...)
+}
+
+// apiKeyRotationDue reports whether the planned change must rotate the secret. It looks only at
+// the plan and the refreshed state, never the clock. A secret without a known issue time
+// (e.g. after import) is treated as expired once rotate_after is set.
+func apiKeyRotationDue(plan, state iamApiKeyModel) bool {
+	if plan.RotationTriggers.IsUnknown() || !plan.RotationTriggers.Equal(state.RotationTriggers) {
+		return true
+	}
+
+	if plan.RotateAfter.IsNull() || plan.RotateAfter.IsUnknown() {
+		return false
+	}
+	if state.RotatedAt.IsNull() || state.RotatedAt.IsUnknown() {
+		return true
+	}
+	return state.RotationDue.ValueBool()
+}
+
+// apiKeyRotationExpired reports whether the secret in state is older than its rotate_after.
+func apiKeyRotationExpired(state iamApiKeyModel, now time.Time) bool {
+	if state.RotateAfter.IsNull() || state.RotateAfter.IsUnknown() || state.RotatedAt.IsNull() || state.RotatedAt.IsUnknown() {
+		return false
+	}
+	rotateAfter, err := parseDuration(state.RotateAfter.ValueString())
+	if err != nil {
+		return false
+	}
+	rotatedAt, err := time.Parse(time.RFC3339, state.RotatedAt.ValueString())
+	if err != nil {
+		return true
+	}
+	return !now.Before(rotatedAt.Add(rotateAfter))
+}
+
 func (r *IamApiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state iamApiKeyModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -204,10 +344,13 @@ func (r *IamApiKeyResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	resourceID := state.ResourceID.ValueString()
-	delURL := joinURL(r.client.iamBaseURL(), fmt.Sprintf("/api-keys/%s", url.PathEscape(resourceID)))
-	_, delDiags := r.client.doJSONAllowNotFound(ctx, "DELETE", delURL, nil, nil)
-	resp.Diagnostics.Append(delDiags...)
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, state.Timeouts.Delete, defaultDeleteTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(deleteApiKey(ctx, r.client, state.ResourceID.ValueString())...)
 }
 
 func (r *IamApiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -239,15 +382,52 @@ func (r *IamApiKeyResource) readIntoState(ctx context.Context, resourceID string
 	state.ResourceID = types.StringValue(out.ApiKey.ResourceID)
 	state.Name = types.StringValue(out.ApiKey.Name)
 	if out.ApiKey.InlinePolicyDocument == nil {
-		state.InlinePolicyDocument = types.StringNull()
+		state.InlinePolicyDocument = jsonStringNull()
 	} else {
 		b, err := json.Marshal(out.ApiKey.InlinePolicyDocument)
 		if err != nil {
 			diags.AddError("inlinePolicyDocument encode error", err.Error())
 			return true, diags
 		}
-		state.InlinePolicyDocument = types.StringValue(string(b))
+		state.InlinePolicyDocument = jsonStringValue(string(b))
 	}
 
 	return true, diags
 }
+
+type apiKeySecretResponse struct {
+	ApiKey struct {
+		ResourceID           string `json:"resourceId"`
+		Name                 string `json:"name"`
+		InlinePolicyDocument any    `json:"inlinePolicyDocument"`
+		ApiSecret            string `json:"apiSecret"`
+	} `json:"apiKey"`
+}
+
+// createApiKey creates an API key. The create response is the only place the secret is returned.
+func createApiKey(ctx context.Context, client *APIClient, apiKey map[string]any) (apiKeySecretResponse, diag.Diagnostics) {
+	var out apiKeySecretResponse
+	payload := map[string]any{"apiKey": apiKey}
+	diags := client.doJSON(ctx, "POST", joinURL(client.iamBaseURL(), "/api-keys"), payload, &out)
+	return out, diags
+}
+
+// rotateApiKey issues a new secret for an existing key. With a grace period the previous secret
+// stays valid for that long; otherwise it is revoked immediately.
+func rotateApiKey(ctx context.Context, client *APIClient, resourceID string, gracePeriod time.Duration) (apiKeySecretResponse, diag.Diagnostics) {
+	var out apiKeySecretResponse
+	payload := map[string]any{}
+	if gracePeriod > 0 {
+		payload["gracePeriodSeconds"] = int64(gracePeriod / time.Second)
+	}
+	rotateURL := joinURL(client.iamBaseURL(), fmt.Sprintf("/api-keys/%s/rotate", url.PathEscape(resourceID)))
+	diags := client.doJSON(ctx, "POST", rotateURL, payload, &out)
+	return out, diags
+}
+
+// deleteApiKey deletes an API key; a key that is already gone is not an error.
+func deleteApiKey(ctx context.Context, client *APIClient, resourceID string) diag.Diagnostics {
+	delURL := joinURL(client.iamBaseURL(), fmt.Sprintf("/api-keys/%s", url.PathEscape(resourceID)))
+	_, diags := client.doJSONAllowNotFound(ctx, "DELETE", delURL, nil, nil)
+	return diags
+}
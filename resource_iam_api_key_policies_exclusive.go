@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+type IamApiKeyPoliciesExclusiveResource struct {
+	policiesExclusiveResource
+}
+
+func NewIamApiKeyPoliciesExclusiveResource() resource.Resource {
+	return &IamApiKeyPoliciesExclusiveResource{
+		policiesExclusiveResource: policiesExclusiveResource{
+			principal:   "API key",
+			idAttribute: "api_key_id",
+			listPolicies: func(ctx context.Context, client *APIClient, apiKeyID string) ([]policyRefModel, bool, diag.Diagnostics) {
+				policies, found, diags := getApiKeyPolicies(ctx, client, apiKeyID)
+				refs := make([]policyRefModel, 0, len(policies))
+				for _, p := range policies {
+					refs = append(refs, newPolicyRef(p.PolicyType, p.PolicyResourceId))
+				}
+				return refs, found, diags
+			},
+			replacePolicies: func(ctx context.Context, client *APIClient, apiKeyID string, refs []policyRefModel) diag.Diagnostics {
+				policies := make([]apiKeyPolicyRef, 0, len(refs))
+				for _, ref := range refs {
+					policies = append(policies, apiKeyPolicyRef{PolicyType: ref.PolicyType.ValueString(), PolicyResourceId: ref.PolicyID.ValueString()})
+				}
+				return replaceApiKeyPolicies(ctx, client, apiKeyID, policies)
+			},
+		},
+	}
+}
+
+var _ resource.Resource = (*IamApiKeyPoliciesExclusiveResource)(nil)
+var _ resource.ResourceWithConfigure = (*IamApiKeyPoliciesExclusiveResource)(nil)
+var _ resource.ResourceWithImportState = (*IamApiKeyPoliciesExclusiveResource)(nil)
+
+func (r *IamApiKeyPoliciesExclusiveResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_api_key_policies_exclusive"
+}
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,10 +21,11 @@ type IamApiKeyPolicyAttachmentResource struct {
 }
 
 type iamApiKeyPolicyAttachmentModel struct {
-	ID         types.String `tfsdk:"id"`
-	ApiKeyID   types.String `tfsdk:"api_key_id"`
-	PolicyType types.String `tfsdk:"policy_type"`
-	PolicyID   types.String `tfsdk:"policy_id"`
+	ID         types.String   `tfsdk:"id"`
+	ApiKeyID   types.String   `tfsdk:"api_key_id"`
+	PolicyType types.String   `tfsdk:"policy_type"`
+	PolicyID   types.String   `tfsdk:"policy_id"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewIamApiKeyPolicyAttachmentResource() resource.Resource {
@@ -38,7 +40,7 @@ func (r *IamApiKeyPolicyAttachmentResource) Metadata(_ context.Context, req reso
 	resp.TypeName = req.ProviderTypeName + "_iam_api_key_policy_attachment"
 }
 
-func (r *IamApiKeyPolicyAttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IamApiKeyPolicyAttachmentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -61,6 +63,9 @@ func (r *IamApiKeyPolicyAttachmentResource) Schema(_ context.Context, _ resource
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -78,6 +83,12 @@ func (r *IamApiKeyPolicyAttachmentResource) Create(ctx context.Context, req reso
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, plan.Timeouts.Create, defaultCreateTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	apiKeyID, ok := requireKnownString(&resp.Diagnostics, plan.ApiKeyID, path.Root("api_key_id"), "api_key_id")
 	if !ok {
 		return
@@ -103,19 +114,9 @@ func (r *IamApiKeyPolicyAttachmentResource) Create(ctx context.Context, req reso
 		return
 	}
 
-	attachPath := fmt.Sprintf("/api-keys/%s/policies/%s", url.PathEscape(apiKeyID), url.PathEscape(policyID))
-	attachURL := joinURLWithQuery(r.client.iamBaseURL(), attachPath, map[string]string{"policyType": policyType})
-	_, status, putDiags := r.client.doJSONInternal(ctx, "PUT", attachURL, nil, nil, false)
-	if putDiags.HasError() {
-		if status == 405 {
-			resp.Diagnostics.Append(r.replaceApiKeyPoliciesAdd(ctx, apiKeyID, policyType, policyID)...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-		} else {
-			resp.Diagnostics.Append(putDiags...)
-			return
-		}
+	resp.Diagnostics.Append(r.attach(ctx, apiKeyID, policyType, policyID)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	plan.ID = types.StringValue(composeAttachmentID(apiKeyID, policyType, policyID))
@@ -129,6 +130,12 @@ func (r *IamApiKeyPolicyAttachmentResource) Read(ctx context.Context, req resour
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, state.Timeouts.Read, defaultReadTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	apiKeyID := state.ApiKeyID.ValueString()
 	policyType := strings.ToLower(state.PolicyType.ValueString())
 	policyID := state.PolicyID.ValueString()
@@ -147,8 +154,14 @@ func (r *IamApiKeyPolicyAttachmentResource) Read(ctx context.Context, req resour
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func (r *IamApiKeyPolicyAttachmentResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError("Unsupported", "All attributes are ForceNew; updates are not supported")
+// Update only ever sees timeouts changes: every other attribute forces replacement.
+func (r *IamApiKeyPolicyAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan iamApiKeyPolicyAttachmentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *IamApiKeyPolicyAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -158,6 +171,12 @@ func (r *IamApiKeyPolicyAttachmentResource) Delete(ctx context.Context, req reso
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, state.Timeouts.Delete, defaultDeleteTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	apiKeyID := state.ApiKeyID.ValueString()
 	policyType := strings.ToLower(state.PolicyType.ValueString())
 	policyID := state.PolicyID.ValueString()
@@ -187,6 +206,18 @@ func (r *IamApiKeyPolicyAttachmentResource) ImportState(ctx context.Context, req
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 }
 
+// attach attaches a policy, falling back to replacing the whole policy list on APIs that do not
+// support attaching a single policy.
+func (r *IamApiKeyPolicyAttachmentResource) attach(ctx context.Context, apiKeyID, policyType, policyID string) diag.Diagnostics {
+	attachPath := fmt.Sprintf("/api-keys/%s/policies/%s", url.PathEscape(apiKeyID), url.PathEscape(policyID))
+	attachURL := joinURLWithQuery(r.client.iamBaseURL(), attachPath, map[string]string{"policyType": policyType})
+	_, status, putDiags := r.client.doJSONInternal(ctx, "PUT", attachURL, nil, nil, false)
+	if putDiags.HasError() && status == 405 {
+		return r.replaceApiKeyPoliciesAdd(ctx, apiKeyID, policyType, policyID)
+	}
+	return putDiags
+}
+
 func (r *IamApiKeyPolicyAttachmentResource) getPolicy(ctx context.Context, policyType, policyID string) diag.Diagnostics {
 	var diags diag.Diagnostics
 	var out any
@@ -230,9 +261,7 @@ func (r *IamApiKeyPolicyAttachmentResource) replaceApiKeyPoliciesAdd(ctx context
 		policies = append(policies, apiKeyPolicyRef{PolicyType: policyType, PolicyResourceId: policyID})
 	}
 
-	payload := map[string]any{"apiKeyPolicies": policies}
-	postURL := joinURL(r.client.iamBaseURL(), fmt.Sprintf("/api-keys/%s/policies", url.PathEscape(apiKeyID)))
-	diags.Append(r.client.doJSON(ctx, "POST", postURL, payload, nil)...)
+	diags.Append(replaceApiKeyPolicies(ctx, r.client, apiKeyID, policies)...)
 	return diags
 }
 
@@ -252,9 +281,7 @@ func (r *IamApiKeyPolicyAttachmentResource) replaceApiKeyPoliciesRemove(ctx cont
 		filtered = append(filtered, p)
 	}
 
-	payload := map[string]any{"apiKeyPolicies": filtered}
-	postURL := joinURL(r.client.iamBaseURL(), fmt.Sprintf("/api-keys/%s/policies", url.PathEscape(apiKeyID)))
-	diags.Append(r.client.doJSON(ctx, "POST", postURL, payload, nil)...)
+	diags.Append(replaceApiKeyPolicies(ctx, r.client, apiKeyID, filtered)...)
 	return diags
 }
 
@@ -264,19 +291,34 @@ type apiKeyPolicyRef struct {
 }
 
 func (r *IamApiKeyPolicyAttachmentResource) listApiKeyPolicies(ctx context.Context, apiKeyID string) ([]apiKeyPolicyRef, diag.Diagnostics) {
-	var diags diag.Diagnostics
+	policies, found, diags := getApiKeyPolicies(ctx, r.client, apiKeyID)
+	if !diags.HasError() && !found {
+		diags.AddError("API key not found", fmt.Sprintf("No API key with resource_id %q exists.", apiKeyID))
+	}
+	return policies, diags
+}
 
+// getApiKeyPolicies lists the policies attached to an API key. found is false when the key
+// does not exist.
+func getApiKeyPolicies(ctx context.Context, client *APIClient, apiKeyID string) ([]apiKeyPolicyRef, bool, diag.Diagnostics) {
 	type policiesResponse struct {
 		ApiKeyPolicies []apiKeyPolicyRef `json:"apiKeyPolicies"`
 	}
 
 	var out policiesResponse
 	path := fmt.Sprintf("/api-keys/%s/policies", url.PathEscape(apiKeyID))
-	diags.Append(r.client.doJSON(ctx, "GET", joinURL(r.client.iamBaseURL(), path), nil, &out)...)
-	if diags.HasError() {
-		return nil, diags
+	found, diags := client.doJSONAllowNotFound(ctx, "GET", joinURL(client.iamBaseURL(), path), nil, &out)
+	if diags.HasError() || !found {
+		return nil, false, diags
 	}
-	return out.ApiKeyPolicies, diags
+	return out.ApiKeyPolicies, true, diags
+}
+
+// replaceApiKeyPolicies sets the full list of policies attached to an API key.
+func replaceApiKeyPolicies(ctx context.Context, client *APIClient, apiKeyID string, policies []apiKeyPolicyRef) diag.Diagnostics {
+	payload := map[string]any{"apiKeyPolicies": policies}
+	postURL := joinURL(client.iamBaseURL(), fmt.Sprintf("/api-keys/%s/policies", url.PathEscape(apiKeyID)))
+	return client.doJSON(ctx, "POST", postURL, payload, nil)
 }
 
 func composeAttachmentID(principalID, policyType, policyID string) string {
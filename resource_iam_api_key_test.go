@@ -6,7 +6,9 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -55,7 +57,7 @@ func TestIamApiKeyResource_Create_InlinePolicyIncludedWhenSet(t *testing.T) {
 	planModel := iamApiKeyModel{
 		ResourceID:           types.StringUnknown(),
 		Name:                 types.StringValue("n"),
-		InlinePolicyDocument: types.StringValue(`{"a":1}`),
+		InlinePolicyDocument: jsonStringValue(`{"a":1}`),
 		ApiSecret:            types.StringUnknown(),
 	}
 
@@ -84,7 +86,7 @@ func TestIamApiKeyResource_Create_InvalidInlinePolicyJSONAddsDiagnostics(t *test
 	r := &IamApiKeyResource{client: c}
 	planModel := iamApiKeyModel{
 		Name:                 types.StringValue("n"),
-		InlinePolicyDocument: types.StringValue(`{bad json}`),
+		InlinePolicyDocument: jsonStringValue(`{bad json}`),
 	}
 
 	var req resource.CreateRequest
@@ -137,6 +139,49 @@ func TestIamApiKeyResource_Read_PreservesApiSecret(t *testing.T) {
 	}
 }
 
+func TestIamApiKeyResource_Read_RecordsRotationDue(t *testing.T) {
+	origNow := apiKeyNowFn
+	apiKeyNowFn = func() time.Time { return time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC) }
+	t.Cleanup(func() { apiKeyNowFn = origNow })
+
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return httpResponse(200, nil, `{"apiKey":{"resourceId":"rid","name":"n","inlinePolicyDocument":null}}`), nil
+	}))
+	r := &IamApiKeyResource{client: c}
+
+	for _, tt := range []struct {
+		rotateAfter types.String
+		rotatedAt   types.String
+		due         bool
+	}{
+		{rotateAfter: types.StringNull(), rotatedAt: types.StringValue("2026-01-01T00:00:00Z"), due: false},
+		{rotateAfter: types.StringValue("91d"), rotatedAt: types.StringValue("2026-01-01T00:00:00Z"), due: false},
+		{rotateAfter: types.StringValue("90d"), rotatedAt: types.StringValue("2026-01-01T00:00:00Z"), due: true},
+		{rotateAfter: types.StringValue("90d"), rotatedAt: types.StringNull(), due: false},
+	} {
+		var req resource.ReadRequest
+		req.State = iamApiKeyState(t, iamApiKeyModel{
+			ResourceID:  types.StringValue("rid"),
+			ApiSecret:   types.StringValue("s"),
+			RotateAfter: tt.rotateAfter,
+			RotatedAt:   tt.rotatedAt,
+		})
+
+		var resp resource.ReadResponse
+		initIamApiKeyState(t, &resp.State)
+		r.Read(context.Background(), req, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+		}
+
+		var got iamApiKeyModel
+		resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+		if got.RotationDue.ValueBool() != tt.due {
+			t.Fatalf("rotate_after=%s rotated_at=%s: expected rotation_due %v, got %s", tt.rotateAfter, tt.rotatedAt, tt.due, got.RotationDue)
+		}
+	}
+}
+
 func TestIamApiKeyResource_Read_NotFoundRemovesResource(t *testing.T) {
 	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
 		return httpResponse(404, nil, `{"code":"NotFound","message":"missing"}`), nil
@@ -222,7 +267,7 @@ func TestIamApiKeyResource_Update_InlinePolicyNullSendsExplicitNull(t *testing.T
 	planModel := iamApiKeyModel{
 		ResourceID:           types.StringValue("rid"),
 		Name:                 types.StringValue("n2"),
-		InlinePolicyDocument: types.StringNull(),
+		InlinePolicyDocument: jsonStringNull(),
 		ApiSecret:            types.StringValue("keepme"),
 	}
 
@@ -265,7 +310,7 @@ func TestIamApiKeyResource_Update_InlinePolicyUnknownOmitsInlinePolicyDocument(t
 	planModel := iamApiKeyModel{
 		ResourceID:           types.StringValue("rid"),
 		Name:                 types.StringValue("n2"),
-		InlinePolicyDocument: types.StringUnknown(),
+		InlinePolicyDocument: jsonStringUnknown(),
 		ApiSecret:            types.StringValue("keepme"),
 	}
 
@@ -335,7 +380,7 @@ func TestIamApiKeyResource_Update_InvalidInlinePolicyJSONAddsDiagnostics_NoHTTP(
 	planModel := iamApiKeyModel{
 		ResourceID:           types.StringValue("rid"),
 		Name:                 types.StringValue("n"),
-		InlinePolicyDocument: types.StringValue(`{bad json}`),
+		InlinePolicyDocument: jsonStringValue(`{bad json}`),
 		ApiSecret:            types.StringValue("keepme"),
 	}
 
@@ -430,3 +475,158 @@ func TestIamApiKeyResource_ImportState_Passthrough(t *testing.T) {
 		t.Fatalf("unexpected imported id: %q", got)
 	}
 }
+
+func TestIamApiKeyResource_Update_RotatesSecretInPlace(t *testing.T) {
+	rotatedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	origNow := apiKeyNowFn
+	apiKeyNowFn = func() time.Time { return rotatedAt }
+	t.Cleanup(func() { apiKeyNowFn = origNow })
+
+	var rotateBody string
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api-keys/rid":
+			return httpResponse(204, nil, ""), nil
+		case r.Method == http.MethodPost && r.URL.Path == "/api-keys/rid/rotate":
+			b, _ := io.ReadAll(r.Body)
+			rotateBody = string(b)
+			return httpResponse(200, nil, `{"apiKey":{"resourceId":"rid","name":"n","apiSecret":"new-secret"}}`), nil
+		case r.Method == http.MethodGet && r.URL.Path == "/api-keys/rid":
+			return httpResponse(200, nil, `{"apiKey":{"resourceId":"rid","name":"n","inlinePolicyDocument":null}}`), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.String())
+			return nil, nil
+		}
+	}))
+
+	r := &IamApiKeyResource{client: c}
+	var req resource.UpdateRequest
+	req.Plan = iamApiKeyPlan(t, iamApiKeyModel{
+		ResourceID:           types.StringValue("rid"),
+		Name:                 types.StringValue("n"),
+		InlinePolicyDocument: jsonStringNull(),
+		ApiSecret:            types.StringUnknown(),
+		RotationGracePeriod:  types.StringValue("1d"),
+		RotatedAt:            types.StringUnknown(),
+	})
+
+	var resp resource.UpdateResponse
+	initIamApiKeyState(t, &resp.State)
+
+	r.Update(context.Background(), req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+	if rotateBody != `{"gracePeriodSeconds":86400}` {
+		t.Fatalf("unexpected rotate payload: %s", rotateBody)
+	}
+
+	var got iamApiKeyModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+	if got.ResourceID.ValueString() != "rid" || got.ApiSecret.ValueString() != "new-secret" {
+		t.Fatalf("expected same key with new secret, got %#v", got)
+	}
+	if got.RotatedAt.ValueString() != "2026-01-02T03:04:05Z" {
+		t.Fatalf("unexpected rotated_at: %s", got.RotatedAt)
+	}
+}
+
+func TestIamApiKeyResource_ModifyPlan_Rotation(t *testing.T) {
+	// ModifyPlan must not consult the clock; any time far past rotate_after proves it.
+	origNow := apiKeyNowFn
+	apiKeyNowFn = func() time.Time { return time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC) }
+	t.Cleanup(func() { apiKeyNowFn = origNow })
+
+	triggers := func(v string) types.Map {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{"version": types.StringValue(v)})
+	}
+	prior := iamApiKeyModel{
+		ResourceID:       types.StringValue("rid"),
+		Name:             types.StringValue("n"),
+		ApiSecret:        types.StringValue("old-secret"),
+		RotationTriggers: triggers("1"),
+		RotatedAt:        types.StringValue("2026-01-01T00:00:00Z"),
+		RotationDue:      types.BoolValue(false),
+	}
+
+	tests := []struct {
+		name   string
+		state  func(m *iamApiKeyModel)
+		plan   func(m *iamApiKeyModel)
+		rotate bool
+	}{
+		{name: "unchanged", rotate: false},
+		{name: "trigger changed", plan: func(m *iamApiKeyModel) { m.RotationTriggers = triggers("2") }, rotate: true},
+		{name: "trigger unknown", plan: func(m *iamApiKeyModel) { m.RotationTriggers = types.MapUnknown(types.StringType) }, rotate: true},
+		{name: "not yet due", plan: func(m *iamApiKeyModel) { m.RotateAfter = types.StringValue("90d") }, rotate: false},
+		{
+			name:   "due",
+			state:  func(m *iamApiKeyModel) { m.RotationDue = types.BoolValue(true) },
+			plan:   func(m *iamApiKeyModel) { m.RotateAfter = types.StringValue("90d") },
+			rotate: true,
+		},
+		{
+			name:   "due but rotate_after removed",
+			state:  func(m *iamApiKeyModel) { m.RotationDue = types.BoolValue(true) },
+			rotate: false,
+		},
+		{
+			name: "imported without rotation settings",
+			state: func(m *iamApiKeyModel) {
+				m.ApiSecret, m.RotatedAt, m.RotationTriggers = types.StringNull(), types.StringNull(), types.Map{}
+			},
+			plan:   func(m *iamApiKeyModel) { m.RotationTriggers = types.Map{} },
+			rotate: false,
+		},
+		{
+			name:   "imported with rotate_after",
+			state:  func(m *iamApiKeyModel) { m.RotatedAt = types.StringNull() },
+			plan:   func(m *iamApiKeyModel) { m.RotateAfter = types.StringValue("90d") },
+			rotate: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stateModel, planModel := prior, prior
+			if tt.state != nil {
+				tt.state(&stateModel)
+			}
+			if tt.plan != nil {
+				tt.plan(&planModel)
+			}
+			// The framework marks computed attributes unknown whenever the plan has a diff.
+			planModel.ApiSecret, planModel.RotatedAt = types.StringUnknown(), types.StringUnknown()
+			planModel.RotationDue = types.BoolUnknown()
+
+			req := resource.ModifyPlanRequest{
+				State: iamApiKeyState(t, stateModel),
+				Plan:  iamApiKeyPlan(t, planModel),
+			}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+
+			(&IamApiKeyResource{}).ModifyPlan(context.Background(), req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+			}
+
+			var got iamApiKeyModel
+			resp.Diagnostics.Append(resp.Plan.Get(context.Background(), &got)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+			}
+			if tt.rotate {
+				if !got.ApiSecret.IsUnknown() || !got.RotatedAt.IsUnknown() || !got.RotationDue.Equal(types.BoolValue(false)) {
+					t.Fatalf("expected rotation, got %#v", got)
+				}
+				return
+			}
+			if !got.ApiSecret.Equal(stateModel.ApiSecret) || !got.RotatedAt.Equal(stateModel.RotatedAt) || !got.RotationDue.Equal(stateModel.RotationDue) {
+				t.Fatalf("expected prior secret, rotated_at and rotation_due, got %#v", got)
+			}
+		})
+	}
+}
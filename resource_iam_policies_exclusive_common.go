@@ -0,0 +1,288 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// policiesExclusiveResource owns every policy attachment of one principal (an API key or a
+// service role). The attribute holding the principal's ID differs per resource, so plan and
+// state are accessed by attribute rather than through a model struct.
+type policiesExclusiveResource struct {
+	client *APIClient
+	// principal names the principal in descriptions, e.g. "API key".
+	principal string
+	// idAttribute is the required attribute holding the principal's resource ID, e.g. api_key_id.
+	idAttribute string
+	// listPolicies returns the principal's attachments; found is false when the principal is gone.
+	listPolicies func(ctx context.Context, client *APIClient, principalID string) ([]policyRefModel, bool, diag.Diagnostics)
+	// replacePolicies replaces every attachment of the principal with refs.
+	replacePolicies func(ctx context.Context, client *APIClient, principalID string, refs []policyRefModel) diag.Diagnostics
+}
+
+// Note: this is an embedded helper; the wrapper resources implement Metadata.
+
+func (r *policiesExclusiveResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	idDescription := strings.ToUpper(r.principal[:1]) + r.principal[1:] + " resource ID."
+	resp.Schema = schema.Schema{
+		Description: "Manages the complete set of policies attached to one " + r.principal + ". Attachments made outside of " +
+			"this resource show up as drift and are removed on apply. Destroying the resource leaves the policies attached.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   idDescription,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			r.idAttribute: schema.StringAttribute{
+				Required:      true,
+				Description:   idDescription,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"policies": policiesExclusiveAttribute(r.principal),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *policiesExclusiveResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*APIClient)
+}
+
+func (r *policiesExclusiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var t timeouts.Value
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("timeouts"), &t)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, t.Create, defaultCreateTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, req.Plan, &resp.State)...)
+}
+
+func (r *policiesExclusiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var principalID types.String
+	var t timeouts.Value
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(r.idAttribute), &principalID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &t)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, t.Read, defaultReadTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refs, found, diags := r.listPolicies(ctx, r.client, principalID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	set, diags := policyRefsSet(refs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.Raw = req.State.Raw
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), principalID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policies"), set)...)
+}
+
+func (r *policiesExclusiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var t timeouts.Value
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("timeouts"), &t)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, t.Update, defaultUpdateTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, req.Plan, &resp.State)...)
+}
+
+// Delete only stops managing the attachments, so that removing the resource doesn't take
+// permissions away from a principal that is still in use. Set policies to [] first to detach
+// everything.
+func (r *policiesExclusiveResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *policiesExclusiveResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.idAttribute), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// apply replaces the principal's attachments with the planned policies and stores the plan.
+func (r *policiesExclusiveResource) apply(ctx context.Context, plan tfsdk.Plan, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var principalID types.String
+	var policies types.Set
+	diags.Append(plan.GetAttribute(ctx, path.Root(r.idAttribute), &principalID)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("policies"), &policies)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	id, ok := requireKnownString(&diags, principalID, path.Root(r.idAttribute), r.idAttribute)
+	if !ok {
+		return diags
+	}
+	refs, refDiags := plannedPolicyRefs(ctx, policies)
+	diags.Append(refDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(verifyPoliciesExist(ctx, r.client, refs)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(r.replacePolicies(ctx, r.client, id, refs)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	state.Raw = plan.Raw
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), types.StringValue(id))...)
+	return diags
+}
+
+// policyRefModel is one element of the policies set of the *_policies_exclusive resources.
+type policyRefModel struct {
+	PolicyType types.String `tfsdk:"policy_type"`
+	PolicyID   types.String `tfsdk:"policy_id"`
+}
+
+var policyRefAttrTypes = map[string]attr.Type{
+	"policy_type": types.StringType,
+	"policy_id":   types.StringType,
+}
+
+func policiesExclusiveAttribute(principal string) schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		Required: true,
+		Description: "Every policy attached to the " + principal + ". Attachments not listed here are detached on apply. " +
+			"An empty set detaches all policies.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"policy_type": schema.StringAttribute{
+					Required:    true,
+					Description: "Policy type. Can be account or managed.",
+					Validators:  []validator.String{stringOneOfValidator{values: []string{"account", "managed"}}},
+				},
+				"policy_id": schema.StringAttribute{
+					Required:    true,
+					Description: "Policy resource ID.",
+				},
+			},
+		},
+	}
+}
+
+// plannedPolicyRefs returns the planned policies sorted by type and ID, so the replace payload
+// doesn't depend on set ordering.
+func plannedPolicyRefs(ctx context.Context, policies types.Set) ([]policyRefModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if policies.IsUnknown() {
+		diags.AddAttributeError(path.Root("policies"), "Unknown policies", "policies must be known before the attachments can be replaced.")
+		return nil, diags
+	}
+
+	var refs []policyRefModel
+	diags.Append(policies.ElementsAs(ctx, &refs, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	for _, ref := range refs {
+		if ref.PolicyType.IsUnknown() || ref.PolicyID.IsUnknown() {
+			diags.AddAttributeError(path.Root("policies"), "Unknown policies", "policies must be known before the attachments can be replaced.")
+			return nil, diags
+		}
+	}
+	sortPolicyRefs(refs)
+	return refs, diags
+}
+
+// policyRefsSet builds the policies set from the attachments reported by the API.
+func policyRefsSet(refs []policyRefModel) (types.Set, diag.Diagnostics) {
+	sortPolicyRefs(refs)
+	elems := make([]attr.Value, 0, len(refs))
+	for _, ref := range refs {
+		elems = append(elems, policyRefObject(ref))
+	}
+	return types.SetValue(types.ObjectType{AttrTypes: policyRefAttrTypes}, elems)
+}
+
+func policyRefObject(ref policyRefModel) types.Object {
+	return types.ObjectValueMust(policyRefAttrTypes, map[string]attr.Value{
+		"policy_type": ref.PolicyType,
+		"policy_id":   ref.PolicyID,
+	})
+}
+
+func newPolicyRef(policyType, policyID string) policyRefModel {
+	return policyRefModel{
+		PolicyType: types.StringValue(strings.ToLower(policyType)),
+		PolicyID:   types.StringValue(policyID),
+	}
+}
+
+func sortPolicyRefs(refs []policyRefModel) {
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].PolicyType.ValueString() != refs[j].PolicyType.ValueString() {
+			return refs[i].PolicyType.ValueString() < refs[j].PolicyType.ValueString()
+		}
+		return refs[i].PolicyID.ValueString() < refs[j].PolicyID.ValueString()
+	})
+}
+
+// verifyPoliciesExist fails fast on a missing policy, reporting it on its set element, before
+// any attachment is replaced.
+func verifyPoliciesExist(ctx context.Context, client *APIClient, refs []policyRefModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	checker := &IamApiKeyPolicyAttachmentResource{client: client}
+	for _, ref := range refs {
+		for _, d := range checker.getPolicy(ctx, ref.PolicyType.ValueString(), ref.PolicyID.ValueString()) {
+			diags.Append(diag.WithPath(path.Root("policies").AtSetValue(policyRefObject(ref)), d))
+		}
+		if diags.HasError() {
+			return diags
+		}
+	}
+	return diags
+}
@@ -0,0 +1,240 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// policiesExclusiveRaw builds plan or state data for a *_policies_exclusive resource; a nil
+// refs slice leaves policies null, as after an import.
+func policiesExclusiveRaw(t *testing.T, s schema.Schema, principalAttr, principalID string, refs []policyRefModel) tftypes.Value {
+	t.Helper()
+	policies := types.SetNull(types.ObjectType{AttrTypes: policyRefAttrTypes})
+	if refs != nil {
+		var diags diag.Diagnostics
+		policies, diags = policyRefsSet(refs)
+		if diags.HasError() {
+			t.Fatalf("policies set: %#v", diags)
+		}
+	}
+	return objectValue(s.Type().TerraformType(context.Background()).(tftypes.Object), map[string]tftypes.Value{
+		principalAttr: tftypes.NewValue(tftypes.String, principalID),
+		"policies":    mustTerraformValue(t, policies),
+	})
+}
+
+func statePolicyRefs(t *testing.T, st tfsdk.State) []policyRefModel {
+	t.Helper()
+	var policies types.Set
+	if diags := st.GetAttribute(context.Background(), path.Root("policies"), &policies); diags.HasError() {
+		t.Fatalf("get policies: %#v", diags)
+	}
+	var refs []policyRefModel
+	if diags := policies.ElementsAs(context.Background(), &refs, false); diags.HasError() {
+		t.Fatalf("policies elements: %#v", diags)
+	}
+	sortPolicyRefs(refs)
+	return refs
+}
+
+func TestIamApiKeyPoliciesExclusiveResource_Create_ReplacesAllAttachments(t *testing.T) {
+	var gotBody string
+	var checked []string
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		switch {
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/policies/"):
+			checked = append(checked, r.URL.Query().Get("policyType")+":"+strings.TrimPrefix(r.URL.Path, "/policies/"))
+			return httpResponse(200, nil, `{}`), nil
+		case r.Method == http.MethodPost && r.URL.Path == "/api-keys/ak/policies":
+			b, _ := io.ReadAll(r.Body)
+			gotBody = string(b)
+			return httpResponse(204, nil, ""), nil
+		default:
+			return httpResponse(500, nil, "unexpected"), nil
+		}
+	}))
+
+	r := NewIamApiKeyPoliciesExclusiveResource().(*IamApiKeyPoliciesExclusiveResource)
+	r.client = c
+	s := resourceSchema(r)
+	refs := []policyRefModel{newPolicyRef("managed", "validator_all_actions"), newPolicyRef("account", "deploy")}
+
+	var resp resource.CreateResponse
+	initSchemaState(t, &resp.State, s)
+	r.Create(context.Background(), resource.CreateRequest{Plan: tfsdk.Plan{Schema: s, Raw: policiesExclusiveRaw(t, s, "api_key_id", "ak", refs)}}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+
+	if len(checked) != 2 || checked[0] != "account:deploy" || checked[1] != "managed:validator_all_actions" {
+		t.Fatalf("expected every policy to be checked, got %v", checked)
+	}
+	want := `{"apiKeyPolicies":[{"policyType":"account","policyResourceId":"deploy"},{"policyType":"managed","policyResourceId":"validator_all_actions"}]}`
+	if gotBody != want {
+		t.Fatalf("unexpected replace body:\n got: %s\nwant: %s", gotBody, want)
+	}
+	var id types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("id"), &id)...)
+	if id.ValueString() != "ak" {
+		t.Fatalf("expected id ak, got %s", id)
+	}
+}
+
+func TestIamApiKeyPoliciesExclusiveResource_Create_MissingPolicyFailsBeforeReplace(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if r.Method != http.MethodGet {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.String())
+		}
+		return httpResponse(404, nil, `{"code":"NotFound","message":"policy not found"}`), nil
+	}))
+
+	r := NewIamApiKeyPoliciesExclusiveResource().(*IamApiKeyPoliciesExclusiveResource)
+	r.client = c
+	s := resourceSchema(r)
+	missing := newPolicyRef("account", "missing")
+
+	var resp resource.CreateResponse
+	initSchemaState(t, &resp.State, s)
+	r.Create(context.Background(), resource.CreateRequest{Plan: tfsdk.Plan{Schema: s, Raw: policiesExclusiveRaw(t, s, "api_key_id", "ak", []policyRefModel{missing})}}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected diagnostics error")
+	}
+	withPath, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+	if !ok || !withPath.Path().Equal(path.Root("policies").AtSetValue(policyRefObject(missing))) {
+		t.Fatalf("expected error on the missing policy, got %#v", resp.Diagnostics.Errors()[0])
+	}
+}
+
+func TestIamApiKeyPoliciesExclusiveResource_Read_ReportsOutOfBandAttachments(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if r.URL.Path != "/api-keys/ak/policies" {
+			return httpResponse(500, nil, "unexpected"), nil
+		}
+		return httpResponse(200, nil, `{"apiKeyPolicies":[{"policyType":"account","policyResourceId":"deploy"},{"policyType":"MANAGED","policyResourceId":"iam_all_actions"}]}`), nil
+	}))
+
+	r := NewIamApiKeyPoliciesExclusiveResource().(*IamApiKeyPoliciesExclusiveResource)
+	r.client = c
+	s := resourceSchema(r)
+
+	var resp resource.ReadResponse
+	initSchemaState(t, &resp.State, s)
+	state := tfsdk.State{Schema: s, Raw: policiesExclusiveRaw(t, s, "api_key_id", "ak", []policyRefModel{newPolicyRef("account", "deploy")})}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+
+	got := statePolicyRefs(t, resp.State)
+	if len(got) != 2 || got[1] != newPolicyRef("managed", "iam_all_actions") {
+		t.Fatalf("expected out-of-band attachment in state, got %#v", got)
+	}
+}
+
+func TestIamApiKeyPoliciesExclusiveResource_Read_MissingKeyRemovesResource(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return httpResponse(404, nil, `{"code":"NotFound"}`), nil
+	}))
+
+	r := NewIamApiKeyPoliciesExclusiveResource().(*IamApiKeyPoliciesExclusiveResource)
+	r.client = c
+	s := resourceSchema(r)
+
+	var resp resource.ReadResponse
+	resp.State = tfsdk.State{Schema: s, Raw: policiesExclusiveRaw(t, s, "api_key_id", "ak", nil)}
+	r.Read(context.Background(), resource.ReadRequest{State: resp.State}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Fatalf("expected resource removed from state")
+	}
+}
+
+func TestIamServiceRolePoliciesExclusiveResource_UpdateThenImportRead(t *testing.T) {
+	var gotBody string
+	attached := `[{"policyType":"account","resourceId":"old"}]`
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service-roles/sr/policies":
+			b, _ := io.ReadAll(r.Body)
+			gotBody = string(b)
+			attached = `[]`
+			return httpResponse(204, nil, ""), nil
+		case r.Method == http.MethodGet && r.URL.Path == "/service-roles/sr" && r.URL.Query().Get("includePolicies") == "true":
+			return httpResponse(200, nil, `{"serviceRole":{"policies":`+attached+`}}`), nil
+		default:
+			return httpResponse(500, nil, "unexpected"), nil
+		}
+	}))
+
+	r := NewIamServiceRolePoliciesExclusiveResource().(*IamServiceRolePoliciesExclusiveResource)
+	r.client = c
+	s := resourceSchema(r)
+	ctx := context.Background()
+
+	// An import only knows the role; Read fills in what is attached.
+	var importResp resource.ImportStateResponse
+	initSchemaState(t, &importResp.State, s)
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "sr"}, &importResp)
+	var readResp resource.ReadResponse
+	initSchemaState(t, &readResp.State, s)
+	r.Read(ctx, resource.ReadRequest{State: importResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", readResp.Diagnostics)
+	}
+	if got := statePolicyRefs(t, readResp.State); len(got) != 1 || got[0] != newPolicyRef("account", "old") {
+		t.Fatalf("unexpected imported policies: %#v", got)
+	}
+
+	// An empty set detaches everything.
+	var updateResp resource.UpdateResponse
+	initSchemaState(t, &updateResp.State, s)
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan{Schema: s, Raw: policiesExclusiveRaw(t, s, "service_role_id", "sr", []policyRefModel{})}}, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", updateResp.Diagnostics)
+	}
+	if gotBody != `{"serviceRolePolicies":[]}` {
+		t.Fatalf("unexpected replace body: %s", gotBody)
+	}
+}
+
+func TestIamPoliciesExclusiveResources_DeleteLeavesAttachments(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected request: %s %s", r.Method, r.URL.String())
+		return nil, nil
+	}))
+
+	apiKey := NewIamApiKeyPoliciesExclusiveResource().(*IamApiKeyPoliciesExclusiveResource)
+	apiKey.client = c
+	serviceRole := NewIamServiceRolePoliciesExclusiveResource().(*IamServiceRolePoliciesExclusiveResource)
+	serviceRole.client = c
+	refs := []policyRefModel{newPolicyRef("account", "deploy")}
+
+	for _, tt := range []struct {
+		r             resource.Resource
+		principalAttr string
+	}{
+		{r: apiKey, principalAttr: "api_key_id"},
+		{r: serviceRole, principalAttr: "service_role_id"},
+	} {
+		s := resourceSchema(tt.r)
+		var resp resource.DeleteResponse
+		resp.State = tfsdk.State{Schema: s, Raw: policiesExclusiveRaw(t, s, tt.principalAttr, "p", refs)}
+		tt.r.Delete(context.Background(), resource.DeleteRequest{State: resp.State}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: unexpected diagnostics: %#v", tt.principalAttr, resp.Diagnostics)
+		}
+	}
+}
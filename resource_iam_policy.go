@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"net/url"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -19,9 +21,10 @@ type IamPolicyResource struct {
 }
 
 type iamPolicyModel struct {
-	ResourceID types.String `tfsdk:"resource_id"`
-	Name       types.String `tfsdk:"name"`
-	Document   types.String `tfsdk:"document"`
+	ResourceID types.String   `tfsdk:"resource_id"`
+	Name       types.String   `tfsdk:"name"`
+	Document   jsonString     `tfsdk:"document"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewIamPolicyResource() resource.Resource {
@@ -36,7 +39,7 @@ func (r *IamPolicyResource) Metadata(_ context.Context, req resource.MetadataReq
 	resp.TypeName = req.ProviderTypeName + "_iam_policy"
 }
 
-func (r *IamPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IamPolicyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"resource_id": schema.StringAttribute{
@@ -54,10 +57,15 @@ func (r *IamPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "Human readable policy name.",
 			},
 			"document": schema.StringAttribute{
+				CustomType:  jsonStringType{},
 				Required:    true,
 				Description: "Policy document JSON (string).",
+				Validators:  []validator.String{policyDocumentValidator{}},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -81,6 +89,12 @@ func (r *IamPolicyResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, plan.Timeouts.Create, defaultCreateTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resourceID, ok := resolveOrGenerateResourceID(&resp.Diagnostics, config.ResourceID, path.Root("resource_id"))
 	if !ok {
 		return
@@ -125,6 +139,12 @@ func (r *IamPolicyResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, state.Timeouts.Read, defaultReadTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resourceID := state.ResourceID.ValueString()
 	found, diags := r.readIntoState(ctx, resourceID, &state)
 	resp.Diagnostics.Append(diags...)
@@ -146,6 +166,12 @@ func (r *IamPolicyResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, plan.Timeouts.Update, defaultUpdateTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resourceID := plan.ResourceID.ValueString()
 	document := parseJSONToAny(&resp.Diagnostics, plan.Document.ValueString(), path.Root("document"), "document")
 	if resp.Diagnostics.HasError() {
@@ -184,6 +210,12 @@ func (r *IamPolicyResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, state.Timeouts.Delete, defaultDeleteTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resourceID := state.ResourceID.ValueString()
 	_, delDiags := r.client.doJSONAllowNotFound(ctx, "DELETE", joinURL(r.client.iamBaseURL(), fmt.Sprintf("/policies/%s", url.PathEscape(resourceID))), nil, nil)
 	resp.Diagnostics.Append(delDiags...)
@@ -228,7 +260,7 @@ func (r *IamPolicyResource) readIntoState(ctx context.Context, resourceID string
 
 	state.ResourceID = types.StringValue(out.Policy.ResourceID)
 	state.Name = types.StringValue(out.Policy.Name)
-	state.Document = types.StringValue(string(doc))
+	state.Document = jsonStringValue(string(doc))
 
 	return true, diags
 }
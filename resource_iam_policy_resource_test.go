@@ -58,12 +58,12 @@ func TestIamPolicyResource_Create_SendsExpectedPayload(t *testing.T) {
 	planModel := iamPolicyModel{
 		ResourceID: types.StringNull(),
 		Name:       types.StringValue("n"),
-		Document:   types.StringValue(`{"a":1}`),
+		Document:   jsonStringValue(`{"a":1}`),
 	}
 	configModel := iamPolicyModel{
 		ResourceID: types.StringValue("rid"),
 		Name:       types.StringValue("n"),
-		Document:   types.StringValue(`{"a":1}`),
+		Document:   jsonStringValue(`{"a":1}`),
 	}
 
 	var req resource.CreateRequest
@@ -96,7 +96,7 @@ func TestIamPolicyResource_Create_ReadBackNotFoundAddsDiagnostics(t *testing.T)
 	}))
 
 	r := &IamPolicyResource{client: c}
-	planModel := iamPolicyModel{Name: types.StringValue("n"), Document: types.StringValue(`{"a":1}`)}
+	planModel := iamPolicyModel{Name: types.StringValue("n"), Document: jsonStringValue(`{"a":1}`)}
 	configModel := iamPolicyModel{ResourceID: types.StringValue("rid")}
 
 	var req resource.CreateRequest
@@ -159,7 +159,7 @@ func TestIamPolicyResource_Update_SendsExpectedPayload(t *testing.T) {
 	planModel := iamPolicyModel{
 		ResourceID: types.StringValue("rid"),
 		Name:       types.StringValue("n2"),
-		Document:   types.StringValue(`{"b":2}`),
+		Document:   jsonStringValue(`{"b":2}`),
 	}
 
 	var req resource.UpdateRequest
@@ -191,7 +191,7 @@ func TestIamPolicyResource_Update_InvalidDocumentJSONAddsDiagnostics_NoHTTP(t *t
 	planModel := iamPolicyModel{
 		ResourceID: types.StringValue("rid"),
 		Name:       types.StringValue("n"),
-		Document:   types.StringValue(`{bad json}`),
+		Document:   jsonStringValue(`{bad json}`),
 	}
 
 	var req resource.UpdateRequest
@@ -227,7 +227,7 @@ func TestIamPolicyResource_Update_ReadBackNotFound_RemovesState(t *testing.T) {
 	planModel := iamPolicyModel{
 		ResourceID: types.StringValue("rid"),
 		Name:       types.StringValue("n"),
-		Document:   types.StringValue(`{"a":1}`),
+		Document:   jsonStringValue(`{"a":1}`),
 	}
 
 	var req resource.UpdateRequest
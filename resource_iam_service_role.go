@@ -6,12 +6,14 @@ import (
 	"fmt"
 	"net/url"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -20,9 +22,10 @@ type IamServiceRoleResource struct {
 }
 
 type iamServiceRoleModel struct {
-	ResourceID           types.String `tfsdk:"resource_id"`
-	Name                 types.String `tfsdk:"name"`
-	InlinePolicyDocument types.String `tfsdk:"inline_policy_document"`
+	ResourceID           types.String   `tfsdk:"resource_id"`
+	Name                 types.String   `tfsdk:"name"`
+	InlinePolicyDocument jsonString     `tfsdk:"inline_policy_document"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewIamServiceRoleResource() resource.Resource {
@@ -37,7 +40,7 @@ func (r *IamServiceRoleResource) Metadata(_ context.Context, req resource.Metada
 	resp.TypeName = req.ProviderTypeName + "_iam_service_role"
 }
 
-func (r *IamServiceRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IamServiceRoleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"resource_id": schema.StringAttribute{
@@ -54,10 +57,15 @@ func (r *IamServiceRoleResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Description: "Human readable service role name.",
 			},
 			"inline_policy_document": schema.StringAttribute{
+				CustomType:  jsonStringType{},
 				Optional:    true,
 				Description: "Inline policy document JSON (string) for this service role.",
+				Validators:  []validator.String{policyDocumentValidator{}},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -81,6 +89,12 @@ func (r *IamServiceRoleResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, plan.Timeouts.Create, defaultCreateTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resourceID, ok := resolveOrGenerateResourceID(&resp.Diagnostics, config.ResourceID, path.Root("resource_id"))
 	if !ok {
 		return
@@ -124,6 +138,12 @@ func (r *IamServiceRoleResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, state.Timeouts.Read, defaultReadTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resourceID := state.ResourceID.ValueString()
 	found, diags := r.readIntoState(ctx, resourceID, &state)
 	resp.Diagnostics.Append(diags...)
@@ -144,6 +164,12 @@ func (r *IamServiceRoleResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, plan.Timeouts.Update, defaultUpdateTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resourceID := plan.ResourceID.ValueString()
 
 	serviceRole := map[string]any{"name": plan.Name.ValueString()}
@@ -182,6 +208,12 @@ func (r *IamServiceRoleResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, state.Timeouts.Delete, defaultDeleteTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resourceID := state.ResourceID.ValueString()
 	delURL := joinURL(r.client.iamBaseURL(), fmt.Sprintf("/service-roles/%s", url.PathEscape(resourceID)))
 	_, delDiags := r.client.doJSONAllowNotFound(ctx, "DELETE", delURL, nil, nil)
@@ -217,14 +249,14 @@ func (r *IamServiceRoleResource) readIntoState(ctx context.Context, resourceID s
 	state.ResourceID = types.StringValue(out.ServiceRole.ResourceID)
 	state.Name = types.StringValue(out.ServiceRole.Name)
 	if out.ServiceRole.InlinePolicyDocument == nil {
-		state.InlinePolicyDocument = types.StringNull()
+		state.InlinePolicyDocument = jsonStringNull()
 	} else {
 		b, err := json.Marshal(out.ServiceRole.InlinePolicyDocument)
 		if err != nil {
 			diags.AddError("inlinePolicyDocument encode error", err.Error())
 			return true, diags
 		}
-		state.InlinePolicyDocument = types.StringValue(string(b))
+		state.InlinePolicyDocument = jsonStringValue(string(b))
 	}
 
 	return true, diags
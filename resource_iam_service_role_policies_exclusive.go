@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+type IamServiceRolePoliciesExclusiveResource struct {
+	policiesExclusiveResource
+}
+
+func NewIamServiceRolePoliciesExclusiveResource() resource.Resource {
+	return &IamServiceRolePoliciesExclusiveResource{
+		policiesExclusiveResource: policiesExclusiveResource{
+			principal:   "service role",
+			idAttribute: "service_role_id",
+			listPolicies: func(ctx context.Context, client *APIClient, serviceRoleID string) ([]policyRefModel, bool, diag.Diagnostics) {
+				policies, found, diags := getServiceRolePolicies(ctx, client, serviceRoleID)
+				refs := make([]policyRefModel, 0, len(policies))
+				for _, p := range policies {
+					refs = append(refs, newPolicyRef(p.PolicyType, p.PolicyResourceId))
+				}
+				return refs, found, diags
+			},
+			replacePolicies: func(ctx context.Context, client *APIClient, serviceRoleID string, refs []policyRefModel) diag.Diagnostics {
+				policies := make([]serviceRolePolicyRef, 0, len(refs))
+				for _, ref := range refs {
+					policies = append(policies, serviceRolePolicyRef{PolicyType: ref.PolicyType.ValueString(), PolicyResourceId: ref.PolicyID.ValueString()})
+				}
+				return replaceServiceRolePolicies(ctx, client, serviceRoleID, policies)
+			},
+		},
+	}
+}
+
+var _ resource.Resource = (*IamServiceRolePoliciesExclusiveResource)(nil)
+var _ resource.ResourceWithConfigure = (*IamServiceRolePoliciesExclusiveResource)(nil)
+var _ resource.ResourceWithImportState = (*IamServiceRolePoliciesExclusiveResource)(nil)
+
+func (r *IamServiceRolePoliciesExclusiveResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_service_role_policies_exclusive"
+}
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,10 +21,11 @@ type IamServiceRolePolicyAttachmentResource struct {
 }
 
 type iamServiceRolePolicyAttachmentModel struct {
-	ID            types.String `tfsdk:"id"`
-	ServiceRoleID types.String `tfsdk:"service_role_id"`
-	PolicyType    types.String `tfsdk:"policy_type"`
-	PolicyID      types.String `tfsdk:"policy_id"`
+	ID            types.String   `tfsdk:"id"`
+	ServiceRoleID types.String   `tfsdk:"service_role_id"`
+	PolicyType    types.String   `tfsdk:"policy_type"`
+	PolicyID      types.String   `tfsdk:"policy_id"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewIamServiceRolePolicyAttachmentResource() resource.Resource {
@@ -38,7 +40,7 @@ func (r *IamServiceRolePolicyAttachmentResource) Metadata(_ context.Context, req
 	resp.TypeName = req.ProviderTypeName + "_iam_service_role_policy_attachment"
 }
 
-func (r *IamServiceRolePolicyAttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IamServiceRolePolicyAttachmentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -61,6 +63,9 @@ func (r *IamServiceRolePolicyAttachmentResource) Schema(_ context.Context, _ res
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -78,6 +83,12 @@ func (r *IamServiceRolePolicyAttachmentResource) Create(ctx context.Context, req
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, plan.Timeouts.Create, defaultCreateTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	serviceRoleID, ok := requireKnownString(&resp.Diagnostics, plan.ServiceRoleID, path.Root("service_role_id"), "service_role_id")
 	if !ok {
 		return
@@ -128,6 +139,12 @@ func (r *IamServiceRolePolicyAttachmentResource) Read(ctx context.Context, req r
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, state.Timeouts.Read, defaultReadTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	serviceRoleID := state.ServiceRoleID.ValueString()
 	policyType := strings.ToLower(state.PolicyType.ValueString())
 	policyID := state.PolicyID.ValueString()
@@ -146,8 +163,14 @@ func (r *IamServiceRolePolicyAttachmentResource) Read(ctx context.Context, req r
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func (r *IamServiceRolePolicyAttachmentResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError("Unsupported", "All attributes are ForceNew; updates are not supported")
+// Update only ever sees timeouts changes: every other attribute forces replacement.
+func (r *IamServiceRolePolicyAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan iamServiceRolePolicyAttachmentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *IamServiceRolePolicyAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -157,6 +180,12 @@ func (r *IamServiceRolePolicyAttachmentResource) Delete(ctx context.Context, req
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, state.Timeouts.Delete, defaultDeleteTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	serviceRoleID := state.ServiceRoleID.ValueString()
 	policyType := strings.ToLower(state.PolicyType.ValueString())
 	policyID := state.PolicyID.ValueString()
@@ -228,9 +257,7 @@ func (r *IamServiceRolePolicyAttachmentResource) replaceServiceRolePoliciesAdd(c
 		policies = append(policies, serviceRolePolicyRef{PolicyType: policyType, PolicyResourceId: policyID})
 	}
 
-	payload := map[string]any{"serviceRolePolicies": policies}
-	postURL := joinURL(r.client.iamBaseURL(), fmt.Sprintf("/service-roles/%s/policies", url.PathEscape(serviceRoleID)))
-	diags.Append(r.client.doJSON(ctx, "POST", postURL, payload, nil)...)
+	diags.Append(replaceServiceRolePolicies(ctx, r.client, serviceRoleID, policies)...)
 	return diags
 }
 
@@ -250,9 +277,7 @@ func (r *IamServiceRolePolicyAttachmentResource) replaceServiceRolePoliciesRemov
 		filtered = append(filtered, p)
 	}
 
-	payload := map[string]any{"serviceRolePolicies": filtered}
-	postURL := joinURL(r.client.iamBaseURL(), fmt.Sprintf("/service-roles/%s/policies", url.PathEscape(serviceRoleID)))
-	diags.Append(r.client.doJSON(ctx, "POST", postURL, payload, nil)...)
+	diags.Append(replaceServiceRolePolicies(ctx, r.client, serviceRoleID, filtered)...)
 	return diags
 }
 
@@ -262,6 +287,13 @@ type serviceRolePolicyRef struct {
 }
 
 func (r *IamServiceRolePolicyAttachmentResource) listServiceRolePoliciesFromServiceRole(ctx context.Context, serviceRoleID string) ([]serviceRolePolicyRef, diag.Diagnostics) {
+	policies, _, diags := getServiceRolePolicies(ctx, r.client, serviceRoleID)
+	return policies, diags
+}
+
+// getServiceRolePolicies lists the policies attached to a service role. found is false when the
+// role does not exist.
+func getServiceRolePolicies(ctx context.Context, client *APIClient, serviceRoleID string) ([]serviceRolePolicyRef, bool, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// In some environments, /service-roles/{id}/policies does not surface MANAGED policies for account-owned roles.
@@ -278,17 +310,17 @@ func (r *IamServiceRolePolicyAttachmentResource) listServiceRolePoliciesFromServ
 
 	var out serviceRoleResponse
 	getURL := joinURLWithQuery(
-		r.client.iamBaseURL(),
+		client.iamBaseURL(),
 		fmt.Sprintf("/service-roles/%s", url.PathEscape(serviceRoleID)),
 		map[string]string{"includePolicies": "true", "resourceOwner": "account"},
 	)
-	found, getDiags := r.client.doJSONAllowNotFound(ctx, "GET", getURL, nil, &out)
+	found, getDiags := client.doJSONAllowNotFound(ctx, "GET", getURL, nil, &out)
 	diags.Append(getDiags...)
 	if diags.HasError() {
-		return nil, diags
+		return nil, false, diags
 	}
 	if !found {
-		return []serviceRolePolicyRef{}, diags
+		return []serviceRolePolicyRef{}, false, diags
 	}
 
 	policies := make([]serviceRolePolicyRef, 0, len(out.ServiceRole.Policies))
@@ -299,5 +331,12 @@ func (r *IamServiceRolePolicyAttachmentResource) listServiceRolePoliciesFromServ
 		policies = append(policies, serviceRolePolicyRef{PolicyType: p.PolicyType, PolicyResourceId: p.ResourceID})
 	}
 
-	return policies, diags
+	return policies, true, diags
+}
+
+// replaceServiceRolePolicies sets the full list of policies attached to a service role.
+func replaceServiceRolePolicies(ctx context.Context, client *APIClient, serviceRoleID string, policies []serviceRolePolicyRef) diag.Diagnostics {
+	payload := map[string]any{"serviceRolePolicies": policies}
+	postURL := joinURL(client.iamBaseURL(), fmt.Sprintf("/service-roles/%s/policies", url.PathEscape(serviceRoleID)))
+	return client.doJSON(ctx, "POST", postURL, payload, nil)
 }
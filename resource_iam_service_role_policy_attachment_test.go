@@ -184,12 +184,40 @@ func TestIamServiceRolePolicyAttachmentResource_Read_AttachedKeepsStateAndSetsID
 	}
 }
 
-func TestIamServiceRolePolicyAttachmentResource_Update_Unsupported(t *testing.T) {
-	r := &IamServiceRolePolicyAttachmentResource{}
+func TestIamServiceRolePolicyAttachmentResource_Update_TimeoutsOnlyPersistsPlan(t *testing.T) {
+	var calls int
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		return httpResponse(500, nil, "unexpected"), nil
+	}))
+
+	r := &IamServiceRolePolicyAttachmentResource{client: c}
+	planModel := iamServiceRolePolicyAttachmentModel{
+		ID:            types.StringValue("sr:account:pid"),
+		ServiceRoleID: types.StringValue("sr"),
+		PolicyType:    types.StringValue("account"),
+		PolicyID:      types.StringValue("pid"),
+		Timeouts:      timeoutsValue(map[string]string{"delete": "45m"}),
+	}
+
+	var req resource.UpdateRequest
+	req.Plan = iamServiceRolePolicyAttachmentPlan(t, planModel)
+
 	var resp resource.UpdateResponse
-	r.Update(context.Background(), resource.UpdateRequest{}, &resp)
-	if !resp.Diagnostics.HasError() {
-		t.Fatalf("expected diagnostics error")
+	initIamServiceRolePolicyAttachmentState(t, &resp.State)
+
+	r.Update(context.Background(), req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+	if calls != 0 {
+		t.Fatalf("expected no http calls, got %d", calls)
+	}
+
+	var got types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("timeouts").AtName("delete"), &got)...)
+	if got.ValueString() != "45m" {
+		t.Fatalf("expected timeouts persisted, got %q", got.ValueString())
 	}
 }
 
@@ -64,12 +64,12 @@ func TestIamServiceRoleResource_Create_SendsExpectedPayload(t *testing.T) {
 	configModel := iamServiceRoleModel{
 		ResourceID:           types.StringValue("rid"),
 		Name:                 types.StringValue("n"),
-		InlinePolicyDocument: types.StringNull(),
+		InlinePolicyDocument: jsonStringNull(),
 	}
 	planModel := iamServiceRoleModel{
 		ResourceID:           types.StringUnknown(),
 		Name:                 types.StringValue("n"),
-		InlinePolicyDocument: types.StringValue(`{"a":1}`),
+		InlinePolicyDocument: jsonStringValue(`{"a":1}`),
 	}
 
 	var req resource.CreateRequest
@@ -153,7 +153,7 @@ func TestIamServiceRoleResource_Create_InvalidInlinePolicyJSONAddsDiagnostics(t
 	planModel := iamServiceRoleModel{
 		ResourceID:           types.StringUnknown(),
 		Name:                 types.StringValue("n"),
-		InlinePolicyDocument: types.StringValue(`{bad json}`),
+		InlinePolicyDocument: jsonStringValue(`{bad json}`),
 	}
 
 	var req resource.CreateRequest
@@ -219,7 +219,7 @@ func TestIamServiceRoleResource_Update_InlinePolicyNullSendsExplicitNull(t *test
 	planModel := iamServiceRoleModel{
 		ResourceID:           types.StringValue("rid"),
 		Name:                 types.StringValue("n2"),
-		InlinePolicyDocument: types.StringNull(),
+		InlinePolicyDocument: jsonStringNull(),
 	}
 
 	var req resource.UpdateRequest
@@ -261,7 +261,7 @@ func TestIamServiceRoleResource_Update_InlinePolicyUnknownOmitsInlinePolicyDocum
 	planModel := iamServiceRoleModel{
 		ResourceID:           types.StringValue("rid"),
 		Name:                 types.StringValue("n2"),
-		InlinePolicyDocument: types.StringUnknown(),
+		InlinePolicyDocument: jsonStringUnknown(),
 	}
 
 	var req resource.UpdateRequest
@@ -293,7 +293,7 @@ func TestIamServiceRoleResource_Update_InvalidInlinePolicyJSONAddsDiagnostics_No
 	planModel := iamServiceRoleModel{
 		ResourceID:           types.StringValue("rid"),
 		Name:                 types.StringValue("n"),
-		InlinePolicyDocument: types.StringValue(`{bad json}`),
+		InlinePolicyDocument: jsonStringValue(`{bad json}`),
 	}
 
 	var req resource.UpdateRequest
@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -22,11 +26,21 @@ var instanceSleepFn = time.Sleep
 var instanceNowFn = time.Now
 
 type instanceModel struct {
-	ResourceID              types.String `tfsdk:"resource_id"`
-	Name                    types.String `tfsdk:"name"`
-	ConfigurationResourceID types.String `tfsdk:"configuration_resource_id"`
-	InlineConfiguration     types.String `tfsdk:"inline_configuration"`
-	Endpoint                types.String `tfsdk:"endpoint"`
+	ResourceID              types.String   `tfsdk:"resource_id"`
+	Name                    types.String   `tfsdk:"name"`
+	ConfigurationResourceID types.String   `tfsdk:"configuration_resource_id"`
+	InlineConfiguration     jsonString     `tfsdk:"inline_configuration"`
+	Endpoint                types.String   `tfsdk:"endpoint"`
+	Status                  types.String   `tfsdk:"status"`
+	Region                  types.String   `tfsdk:"region"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+}
+
+// instanceSettingsModel is the instance model for services whose inline configuration can also
+// be written as typed settings.
+type instanceSettingsModel struct {
+	instanceModel
+	InlineSettings types.Object `tfsdk:"inline_settings"`
 }
 
 type instanceReadResponse struct {
@@ -36,9 +50,44 @@ type instanceReadResponse struct {
 		ConfigurationResourceID string `json:"configurationResourceId"`
 		InlineConfiguration     any    `json:"inlineConfiguration"`
 		Endpoint                string `json:"endpoint"`
+		Status                  string `json:"status"`
 	} `json:"instance"`
 }
 
+// instanceDesiredStatuses are the states an instance can be asked to converge to.
+var instanceDesiredStatuses = []string{"RUNNING", "STOPPED", "SUSPENDED"}
+
+// instanceFailedStatuses are terminal states; waiting for any other status stops early.
+var instanceFailedStatuses = []string{"FAILED", "ERROR"}
+
+// Polling loops run until the operation deadline; these bound them when the context has none.
+const (
+	instanceStatusMaxAttempts = 30
+	instanceDeleteMaxAttempts = 8
+)
+
+// instanceStatusAliases maps other names the API uses for a serving instance onto RUNNING, the
+// status the instances contract documents. normalizeInstanceStatus is the only place that reads it.
+var instanceStatusAliases = map[string]string{
+	"READY":  "RUNNING",
+	"ACTIVE": "RUNNING",
+}
+
+// instanceParkedStatuses are settled states in which an instance is not expected to serve.
+var instanceParkedStatuses = []string{"STOPPED", "SUSPENDED"}
+
+type instanceListItem struct {
+	ResourceID              string `json:"resourceId"`
+	Name                    string `json:"name"`
+	Status                  string `json:"status"`
+	ConfigurationResourceID string `json:"configurationResourceId"`
+	Endpoint                string `json:"endpoint"`
+}
+
+func listInstances(ctx context.Context, client *APIClient, baseURL string) ([]instanceListItem, diag.Diagnostics) {
+	return listAllPages[instanceListItem](ctx, client, baseURL, "/instances", "instances")
+}
+
 func instanceEndpointToState(endpoint string) types.String {
 	if endpoint == "" {
 		return types.StringNull()
@@ -46,6 +95,53 @@ func instanceEndpointToState(endpoint string) types.String {
 	return types.StringValue(endpoint)
 }
 
+// instanceResponseToModel maps a read response onto the shared instance model used by
+// both the instance resources and the instance data sources.
+func instanceResponseToModel(out instanceReadResponse, inlineJSON string, state *instanceModel) {
+	state.ResourceID = types.StringValue(out.Instance.ResourceID)
+	state.Name = types.StringValue(out.Instance.Name)
+	if out.Instance.ConfigurationResourceID == "" {
+		state.ConfigurationResourceID = types.StringNull()
+	} else {
+		state.ConfigurationResourceID = types.StringValue(out.Instance.ConfigurationResourceID)
+	}
+	if out.Instance.InlineConfiguration == nil {
+		state.InlineConfiguration = jsonStringNull()
+	} else {
+		state.InlineConfiguration = jsonStringValue(inlineJSON)
+	}
+	state.Endpoint = instanceEndpointToState(out.Instance.Endpoint)
+	state.Status = instanceStatusToState(out.Instance.Status)
+}
+
+// normalizeInstanceStatus maps a reported status onto the vocabulary of the status attribute:
+// uppercase, with aliases of RUNNING resolved. Every status comparison goes through it, so a
+// server answering READY or running doesn't differ from a configured RUNNING.
+func normalizeInstanceStatus(status string) string {
+	upper := strings.ToUpper(strings.TrimSpace(status))
+	if alias, ok := instanceStatusAliases[upper]; ok {
+		return alias
+	}
+	return upper
+}
+
+func instanceStatusToState(status string) types.String {
+	if status == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(normalizeInstanceStatus(status))
+}
+
+func instanceStatusSchemaAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Description: "Instance status. Reports the platform status; set to RUNNING, STOPPED or SUSPENDED to " +
+			"drive the instance to that state. READY and ACTIVE are reported as RUNNING. If omitted, the status is not managed.",
+		Validators: []validator.String{instanceStatusValidator{}},
+	}
+}
+
 func instanceEndpointSchemaAttribute() schema.StringAttribute {
 	return schema.StringAttribute{
 		Computed:    true,
@@ -92,8 +188,7 @@ func deleteInstance(ctx context.Context, client *APIClient, baseURL, resourceID
 
 	// Deletions can be eventually consistent. Wait until the instance is not found before
 	// returning so dependent deletes (e.g. configurations) don't race.
-	const maxAttempts = 8
-	for attempt := 1; attempt <= maxAttempts; attempt++ {
+	for attempt := 1; attemptsRemain(ctx, attempt, instanceDeleteMaxAttempts); attempt++ {
 		var out any
 		stillThere, getDiags := client.doJSONAllowNotFound(ctx, "GET", instanceURL, nil, &out)
 		diags.Append(getDiags...)
@@ -111,10 +206,104 @@ func deleteInstance(ctx context.Context, client *APIClient, baseURL, resourceID
 		instanceSleepFn(sleep)
 	}
 
-	diags.AddError("Timed out waiting for deletion", "Instance deletion was accepted but the instance is still readable after multiple attempts")
+	diags.AddError("Timed out waiting for deletion", "Instance deletion was accepted but the instance is still readable. "+
+		"Increase the delete timeout in the timeouts block if the region is slow to deprovision.")
+	return diags
+}
+
+func setInstanceStatus(ctx context.Context, client *APIClient, baseURL, resourceID, status string) diag.Diagnostics {
+	statusURL := joinURL(baseURL, fmt.Sprintf("/instances/%s/status", url.PathEscape(resourceID)))
+	return client.doJSON(ctx, "PUT", statusURL, map[string]any{"status": status}, nil)
+}
+
+// waitForInstanceStatus polls the instance until it reports the desired status. Transitions
+// are asynchronous, so the status endpoint accepting a request does not mean it has converged.
+func waitForInstanceStatus(ctx context.Context, client *APIClient, baseURL, resourceID, desired string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	last := ""
+	for attempt := 1; attemptsRemain(ctx, attempt, instanceStatusMaxAttempts); attempt++ {
+		found, out, _, getDiags := readInstanceIntoState(ctx, client, baseURL, resourceID)
+		diags.Append(getDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		if !found {
+			diags.AddError("Instance not found", fmt.Sprintf("Instance %q disappeared while waiting for status %s", resourceID, desired))
+			return diags
+		}
+
+		last = out.Instance.Status
+		if normalizeInstanceStatus(last) == normalizeInstanceStatus(desired) {
+			return diags
+		}
+		if statusIn(last, instanceFailedStatuses) {
+			diags.AddError("Instance status transition failed", fmt.Sprintf("Instance %q reported status %s while waiting for %s", resourceID, last, desired))
+			return diags
+		}
+
+		sleep, ok := retrySleep(ctx, attempt, "", instanceNowFn())
+		if !ok {
+			break
+		}
+		instanceSleepFn(sleep)
+	}
+
+	diags.AddError("Timed out waiting for instance status", fmt.Sprintf("Instance %q did not reach status %s (last reported: %q)", resourceID, desired, last))
+	return diags
+}
+
+// waitForInstanceReady polls the instance until it reports a ready status and a non-empty
+// endpoint. The wait is bounded by the context deadline, which callers derive from the
+// resource's timeouts block. Instances in a parked status (STOPPED, SUSPENDED) are not
+// waited on, and an API that does not report a status is considered ready once the
+// endpoint is populated.
+func waitForInstanceReady(ctx context.Context, client *APIClient, baseURL, resourceID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	lastStatus, lastEndpoint := "", ""
+	for attempt := 1; attemptsRemain(ctx, attempt, instanceStatusMaxAttempts); attempt++ {
+		found, out, _, getDiags := readInstanceIntoState(ctx, client, baseURL, resourceID)
+		diags.Append(getDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		if !found {
+			diags.AddError("Instance not found", fmt.Sprintf("Instance %q disappeared while waiting for it to become ready", resourceID))
+			return diags
+		}
+
+		lastStatus, lastEndpoint = out.Instance.Status, out.Instance.Endpoint
+		if statusIn(lastStatus, instanceFailedStatuses) {
+			diags.AddError("Instance failed to become ready", fmt.Sprintf("Instance %q reported status %s", resourceID, lastStatus))
+			return diags
+		}
+		if statusIn(lastStatus, instanceParkedStatuses) {
+			return diags
+		}
+		if lastEndpoint != "" && (lastStatus == "" || normalizeInstanceStatus(lastStatus) == "RUNNING") {
+			return diags
+		}
+
+		sleep, ok := retrySleep(ctx, attempt, "", instanceNowFn())
+		if !ok {
+			break
+		}
+		instanceSleepFn(sleep)
+	}
+
+	diags.AddError(
+		"Timed out waiting for instance to become ready",
+		fmt.Sprintf("Instance %q was not ready before the timeout (last reported status: %q, endpoint: %q). "+
+			"Increase the timeouts block if the region is slow to provision.", resourceID, lastStatus, lastEndpoint),
+	)
 	return diags
 }
 
+func statusIn(status string, candidates []string) bool {
+	return slices.Contains(candidates, normalizeInstanceStatus(status))
+}
+
 func readInstanceIntoState(ctx context.Context, client *APIClient, baseURL, resourceID string) (bool, instanceReadResponse, string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
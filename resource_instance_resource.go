@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -10,8 +11,9 @@ import (
 )
 
 type instanceResource struct {
-	client  *APIClient
-	baseURL func(*APIClient) string
+	client *APIClient
+	// baseURL returns the service's management base URL in region (empty for the provider region).
+	baseURL func(client *APIClient, region string) string
 }
 
 // Note: this is an embedded helper; the wrapper resources implement Metadata/Schema.
@@ -36,34 +38,7 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	resourceID, ok := resolveOrGenerateResourceID(&resp.Diagnostics, config.ResourceID, path.Root("resource_id"))
-	if !ok {
-		return
-	}
-
-	instance := map[string]any{
-		"name": plan.Name.ValueString(),
-	}
-	if plan.ConfigurationResourceID.IsNull() {
-		instance["configurationResourceId"] = nil
-	} else if !plan.ConfigurationResourceID.IsUnknown() {
-		instance["configurationResourceId"] = plan.ConfigurationResourceID.ValueString()
-	}
-	if !plan.InlineConfiguration.IsNull() && !plan.InlineConfiguration.IsUnknown() {
-		instance["inlineConfiguration"] = parseJSONToAny(&resp.Diagnostics, plan.InlineConfiguration.ValueString(), path.Root("inline_configuration"), "inline_configuration")
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	}
-
-	payload := instanceCreatePayload(resourceID, instance)
-	resp.Diagnostics.Append(createInstance(ctx, r.client, r.baseURL(r.client), payload)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	_, diags := r.readIntoState(ctx, resourceID, &plan)
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(r.create(ctx, config.ResourceID, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -77,8 +52,7 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	resourceID := state.ResourceID.ValueString()
-	found, diags := r.readIntoState(ctx, resourceID, &state)
+	found, diags := r.read(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -97,7 +71,110 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	resp.Diagnostics.Append(r.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state instanceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.delete(ctx, &state)...)
+}
+
+// create, read, update and delete operate on the shared instance model so wrappers that extend
+// the schema with service-specific attributes (embedding instanceModel) can reuse them.
+
+func (r *instanceResource) create(ctx context.Context, configResourceID types.String, plan *instanceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ctx, cancel := timeoutContext(ctx, &diags, plan.Timeouts.Create, defaultCreateTimeout)
+	defer cancel()
+	if diags.HasError() {
+		return diags
+	}
+
+	resourceID, ok := resolveOrGenerateResourceID(&diags, configResourceID, path.Root("resource_id"))
+	if !ok {
+		return diags
+	}
+	plan.Region = r.client.resolveRegion(plan.Region)
+	baseURL := r.regionBaseURL(plan)
+
+	instance := map[string]any{
+		"name": plan.Name.ValueString(),
+	}
+	if plan.ConfigurationResourceID.IsNull() {
+		instance["configurationResourceId"] = nil
+	} else if !plan.ConfigurationResourceID.IsUnknown() {
+		instance["configurationResourceId"] = plan.ConfigurationResourceID.ValueString()
+	}
+	if !plan.InlineConfiguration.IsNull() && !plan.InlineConfiguration.IsUnknown() {
+		instance["inlineConfiguration"] = parseJSONToAny(&diags, plan.InlineConfiguration.ValueString(), path.Root("inline_configuration"), "inline_configuration")
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	payload := instanceCreatePayload(resourceID, instance)
+	diags.Append(createInstance(ctx, r.client, baseURL, payload)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Consumers of endpoint race the instance coming up, so only return once it serves. A status
+	// change is only accepted once provisioning has finished.
+	diags.Append(waitForInstanceReady(ctx, r.client, baseURL, resourceID)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(r.reconcileStatus(ctx, baseURL, resourceID, plan.Status)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	_, readDiags := r.readIntoState(ctx, resourceID, plan)
+	diags.Append(readDiags...)
+	return diags
+}
+
+func (r *instanceResource) read(ctx context.Context, state *instanceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ctx, cancel := timeoutContext(ctx, &diags, state.Timeouts.Read, defaultReadTimeout)
+	defer cancel()
+	if diags.HasError() {
+		return false, diags
+	}
+
+	// State written before region existed, or imported without one, is in the provider region.
+	state.Region = r.client.resolveRegion(state.Region)
+
+	found, readDiags := r.readIntoState(ctx, state.ResourceID.ValueString(), state)
+	diags.Append(readDiags...)
+	return found, diags
+}
+
+func (r *instanceResource) update(ctx context.Context, plan *instanceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ctx, cancel := timeoutContext(ctx, &diags, plan.Timeouts.Update, defaultUpdateTimeout)
+	defer cancel()
+	if diags.HasError() {
+		return diags
+	}
+
 	resourceID := plan.ResourceID.ValueString()
+	plan.Region = r.client.resolveRegion(plan.Region)
+	baseURL := r.regionBaseURL(plan)
+
 	instance := map[string]any{
 		"name": plan.Name.ValueString(),
 	}
@@ -109,43 +186,88 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 	if plan.InlineConfiguration.IsNull() {
 		// omit to keep server default when unset
 	} else if !plan.InlineConfiguration.IsUnknown() {
-		instance["inlineConfiguration"] = parseJSONToAny(&resp.Diagnostics, plan.InlineConfiguration.ValueString(), path.Root("inline_configuration"), "inline_configuration")
-		if resp.Diagnostics.HasError() {
-			return
+		instance["inlineConfiguration"] = parseJSONToAny(&diags, plan.InlineConfiguration.ValueString(), path.Root("inline_configuration"), "inline_configuration")
+		if diags.HasError() {
+			return diags
 		}
 	}
 
 	payload := instanceUpdatePayload(instance)
-	resp.Diagnostics.Append(updateInstance(ctx, r.client, r.baseURL(r.client), resourceID, payload)...)
-	if resp.Diagnostics.HasError() {
-		return
+	diags.Append(updateInstance(ctx, r.client, baseURL, resourceID, payload)...)
+	if diags.HasError() {
+		return diags
 	}
 
-	_, diags := r.readIntoState(ctx, resourceID, &plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	// Consumers of endpoint race the instance coming up, so only return once it serves. A status
+	// change is only accepted once provisioning has finished.
+	diags.Append(waitForInstanceReady(ctx, r.client, baseURL, resourceID)...)
+	if diags.HasError() {
+		return diags
 	}
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	diags.Append(r.reconcileStatus(ctx, baseURL, resourceID, plan.Status)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	_, readDiags := r.readIntoState(ctx, resourceID, plan)
+	diags.Append(readDiags...)
+	return diags
 }
 
-func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state instanceModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
+func (r *instanceResource) delete(ctx context.Context, state *instanceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ctx, cancel := timeoutContext(ctx, &diags, state.Timeouts.Delete, defaultDeleteTimeout)
+	defer cancel()
+	if diags.HasError() {
+		return diags
 	}
 
-	resourceID := state.ResourceID.ValueString()
-	resp.Diagnostics.Append(deleteInstance(ctx, r.client, r.baseURL(r.client), resourceID)...)
+	diags.Append(deleteInstance(ctx, r.client, r.regionBaseURL(state), state.ResourceID.ValueString())...)
+	return diags
 }
 
 func (r *instanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("resource_id"), req, resp)
+	importRegionalState(ctx, req, resp)
+}
+
+// regionBaseURL is the service base URL for the model's region.
+func (r *instanceResource) regionBaseURL(m *instanceModel) string {
+	return r.baseURL(r.client, m.Region.ValueString())
+}
+
+// reconcileStatus drives the instance to the desired status when the reported status differs.
+// A null or unknown desired status means the status is not managed by this configuration.
+func (r *instanceResource) reconcileStatus(ctx context.Context, baseURL, resourceID string, desired types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if desired.IsNull() || desired.IsUnknown() {
+		return diags
+	}
+
+	found, out, _, getDiags := readInstanceIntoState(ctx, r.client, baseURL, resourceID)
+	diags.Append(getDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	if !found {
+		diags.AddError("Instance not found", fmt.Sprintf("Instance %q could not be read before changing its status", resourceID))
+		return diags
+	}
+	if normalizeInstanceStatus(out.Instance.Status) == desired.ValueString() {
+		return diags
+	}
+
+	diags.Append(setInstanceStatus(ctx, r.client, baseURL, resourceID, desired.ValueString())...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(waitForInstanceStatus(ctx, r.client, baseURL, resourceID, desired.ValueString())...)
+	return diags
 }
 
 func (r *instanceResource) readIntoState(ctx context.Context, resourceID string, state *instanceModel) (bool, diag.Diagnostics) {
-	found, out, inlineJSON, diags := readInstanceIntoState(ctx, r.client, r.baseURL(r.client), resourceID)
+	found, out, inlineJSON, diags := readInstanceIntoState(ctx, r.client, r.regionBaseURL(state), resourceID)
 	if diags.HasError() {
 		return false, diags
 	}
@@ -153,19 +275,85 @@ func (r *instanceResource) readIntoState(ctx context.Context, resourceID string,
 		return false, diags
 	}
 
-	state.ResourceID = types.StringValue(out.Instance.ResourceID)
-	state.Name = types.StringValue(out.Instance.Name)
-	if out.Instance.ConfigurationResourceID == "" {
-		state.ConfigurationResourceID = types.StringNull()
-	} else {
-		state.ConfigurationResourceID = types.StringValue(out.Instance.ConfigurationResourceID)
+	instanceResponseToModel(out, inlineJSON, state)
+
+	return true, diags
+}
+
+// instanceSettingsResource is the instance resource for services that accept typed
+// inline_settings as an alternative to inline_configuration. The typed form is rendered into
+// inline_configuration at plan time, so the shared CRUD above sends it unchanged.
+type instanceSettingsResource struct {
+	instanceResource
+}
+
+func (r *instanceSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateSettingsConfig(ctx, req.Config, &resp.Diagnostics, "inline_configuration", "inline_settings", false)
+}
+
+func (r *instanceSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planJSONFromSettings(ctx, req, resp, "inline_configuration", "inline_settings")
+}
+
+func (r *instanceSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var config instanceSettingsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if out.Instance.InlineConfiguration == nil {
-		state.InlineConfiguration = types.StringNull()
-	} else {
-		state.InlineConfiguration = types.StringValue(inlineJSON)
+
+	var plan instanceSettingsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	state.Endpoint = instanceEndpointToState(out.Instance.Endpoint)
 
-	return true, diags
+	resp.Diagnostics.Append(r.create(ctx, config.ResourceID, &plan.instanceModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *instanceSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state instanceSettingsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.read(ctx, &state.instanceModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *instanceSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan instanceSettingsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan.instanceModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *instanceSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state instanceSettingsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.delete(ctx, &state.instanceModel)...)
 }
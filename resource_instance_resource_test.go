@@ -71,13 +71,13 @@ func TestInstanceResource_Create_SendsExpectedPayload(t *testing.T) {
 	}
 	t.Cleanup(func() { cryptoRandRead = oldRead })
 
-	r := &instanceResource{client: c, baseURL: func(*APIClient) string { return "https://example.com" }}
+	r := &instanceResource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
 
 	plan := instanceModel{
 		ResourceID:              types.StringNull(),
 		Name:                    types.StringValue("n"),
 		ConfigurationResourceID: types.StringValue("cid"),
-		InlineConfiguration:     types.StringNull(),
+		InlineConfiguration:     jsonStringNull(),
 		Endpoint:                types.StringNull(),
 	}
 	config := instanceModel{ResourceID: types.StringNull(), Endpoint: types.StringNull()}
@@ -134,13 +134,13 @@ func TestInstanceResource_Create_NullConfigurationResourceIdSendsExplicitNull(t
 		return httpResponse(200, nil, `{"instance":{"resourceId":"rid","name":"n","configurationResourceId":"","inlineConfiguration":null,"endpoint":"https://example.invalid"}}`), nil
 	}))
 
-	r := &instanceResource{client: c, baseURL: func(*APIClient) string { return "https://example.com" }}
+	r := &instanceResource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
 
 	plan := instanceModel{
 		ResourceID:              types.StringValue("rid"),
 		Name:                    types.StringValue("n"),
 		ConfigurationResourceID: types.StringNull(),
-		InlineConfiguration:     types.StringNull(),
+		InlineConfiguration:     jsonStringNull(),
 		Endpoint:                types.StringNull(),
 	}
 	config := instanceModel{ResourceID: types.StringValue("rid"), Endpoint: types.StringNull()}
@@ -162,13 +162,13 @@ func TestInstanceResource_Create_InlineConfigurationInvalidJSONAddsDiagnostics(t
 		return nil, nil
 	}))
 
-	r := &instanceResource{client: c, baseURL: func(*APIClient) string { return "https://example.com" }}
+	r := &instanceResource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
 
 	plan := instanceModel{
 		ResourceID:              types.StringValue("rid"),
 		Name:                    types.StringValue("n"),
 		ConfigurationResourceID: types.StringNull(),
-		InlineConfiguration:     types.StringValue("not-json"),
+		InlineConfiguration:     jsonStringValue("not-json"),
 		Endpoint:                types.StringNull(),
 	}
 	config := instanceModel{ResourceID: types.StringValue("rid"), Endpoint: types.StringNull()}
@@ -195,12 +195,12 @@ func TestInstanceResource_Create_InlineConfigurationIncludedWhenSet(t *testing.T
 		return httpResponse(200, nil, `{"instance":{"resourceId":"rid","name":"n","configurationResourceId":"","inlineConfiguration":{},"endpoint":"https://example.invalid"}}`), nil
 	}))
 
-	r := &instanceResource{client: c, baseURL: func(*APIClient) string { return "https://example.com" }}
+	r := &instanceResource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
 	plan := instanceModel{
 		ResourceID:              types.StringValue("rid"),
 		Name:                    types.StringValue("n"),
 		ConfigurationResourceID: types.StringNull(),
-		InlineConfiguration:     types.StringValue(`{"a":1}`),
+		InlineConfiguration:     jsonStringValue(`{"a":1}`),
 		Endpoint:                types.StringNull(),
 	}
 	config := instanceModel{ResourceID: types.StringValue("rid"), Endpoint: types.StringNull()}
@@ -230,12 +230,12 @@ func TestInstanceResource_Update_OmitsInlineConfigurationWhenNull(t *testing.T)
 		return httpResponse(200, nil, `{"instance":{"resourceId":"rid","name":"n","configurationResourceId":"","inlineConfiguration":null,"endpoint":"https://example.invalid"}}`), nil
 	}))
 
-	r := &instanceResource{client: c, baseURL: func(*APIClient) string { return "https://example.com" }}
+	r := &instanceResource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
 	plan := instanceModel{
 		ResourceID:              types.StringValue("rid"),
 		Name:                    types.StringValue("n"),
 		ConfigurationResourceID: types.StringNull(),
-		InlineConfiguration:     types.StringNull(),
+		InlineConfiguration:     jsonStringNull(),
 		Endpoint:                types.StringNull(),
 	}
 
@@ -267,12 +267,12 @@ func TestInstanceResource_Update_OmitsFieldsWhenUnknown(t *testing.T) {
 		return httpResponse(200, nil, `{"instance":{"resourceId":"rid","name":"n","configurationResourceId":"","inlineConfiguration":null,"endpoint":"https://example.invalid"}}`), nil
 	}))
 
-	r := &instanceResource{client: c, baseURL: func(*APIClient) string { return "https://example.com" }}
+	r := &instanceResource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
 	plan := instanceModel{
 		ResourceID:              types.StringValue("rid"),
 		Name:                    types.StringValue("n"),
 		ConfigurationResourceID: types.StringUnknown(),
-		InlineConfiguration:     types.StringUnknown(),
+		InlineConfiguration:     jsonStringUnknown(),
 		Endpoint:                types.StringNull(),
 	}
 
@@ -300,12 +300,12 @@ func TestInstanceResource_Update_InvalidInlineConfigurationJSONAddsDiagnostics_N
 		return httpResponse(500, nil, "unexpected"), nil
 	}))
 
-	r := &instanceResource{client: c, baseURL: func(*APIClient) string { return "https://example.com" }}
+	r := &instanceResource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
 	plan := instanceModel{
 		ResourceID:              types.StringValue("rid"),
 		Name:                    types.StringValue("n"),
 		ConfigurationResourceID: types.StringValue("cid"),
-		InlineConfiguration:     types.StringValue(`{bad json}`),
+		InlineConfiguration:     jsonStringValue(`{bad json}`),
 		Endpoint:                types.StringNull(),
 	}
 
@@ -334,12 +334,12 @@ func TestInstanceResource_Update_InlineConfigurationIncludedWhenSet(t *testing.T
 		return httpResponse(200, nil, `{"instance":{"resourceId":"rid","name":"n","configurationResourceId":"","inlineConfiguration":{},"endpoint":"https://example.invalid"}}`), nil
 	}))
 
-	r := &instanceResource{client: c, baseURL: func(*APIClient) string { return "https://example.com" }}
+	r := &instanceResource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
 	plan := instanceModel{
 		ResourceID:              types.StringValue("rid"),
 		Name:                    types.StringValue("n"),
 		ConfigurationResourceID: types.StringNull(),
-		InlineConfiguration:     types.StringValue(`{"a":1}`),
+		InlineConfiguration:     jsonStringValue(`{"a":1}`),
 		Endpoint:                types.StringNull(),
 	}
 
@@ -371,12 +371,12 @@ func TestInstanceResource_Update_NullConfigurationResourceIdSendsExplicitNull(t
 		return httpResponse(200, nil, `{"instance":{"resourceId":"rid","name":"n","configurationResourceId":"","inlineConfiguration":null,"endpoint":"https://example.invalid"}}`), nil
 	}))
 
-	r := &instanceResource{client: c, baseURL: func(*APIClient) string { return "https://example.com" }}
+	r := &instanceResource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
 	plan := instanceModel{
 		ResourceID:              types.StringValue("rid"),
 		Name:                    types.StringValue("n"),
 		ConfigurationResourceID: types.StringNull(),
-		InlineConfiguration:     types.StringNull(),
+		InlineConfiguration:     jsonStringNull(),
 		Endpoint:                types.StringNull(),
 	}
 
@@ -394,8 +394,318 @@ func TestInstanceResource_Update_NullConfigurationResourceIdSendsExplicitNull(t
 	}
 }
 
+func TestInstanceResource_Update_DrivesStatusTransition(t *testing.T) {
+	stubInstanceSleep(t)
+
+	var requests []string
+	current := "RUNNING"
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/instances/rid/status":
+			b, _ := io.ReadAll(r.Body)
+			if string(b) != `{"status":"STOPPED"}` {
+				return httpResponse(500, nil, "unexpected body: "+string(b)), nil
+			}
+			current = "STOPPING"
+			return httpResponse(202, nil, ``), nil
+		case r.Method == http.MethodPut:
+			return httpResponse(204, nil, ``), nil
+		default:
+			status := current
+			if current == "STOPPING" {
+				// Reported in lowercase to check state keeps the configured case.
+				current = "stopped"
+			}
+			return httpResponse(200, nil, `{"instance":{"resourceId":"rid","name":"n","endpoint":"https://e","status":"`+status+`"}}`), nil
+		}
+	}))
+
+	r := &instanceResource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
+	plan := instanceModel{
+		ResourceID:              types.StringValue("rid"),
+		Name:                    types.StringValue("n"),
+		ConfigurationResourceID: types.StringNull(),
+		InlineConfiguration:     jsonStringNull(),
+		Endpoint:                types.StringNull(),
+		Status:                  types.StringValue("STOPPED"),
+	}
+
+	var resp resource.UpdateResponse
+	initResourceState(t, &resp.State)
+	r.Update(context.Background(), resource.UpdateRequest{Plan: instancePlan(t, plan)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+
+	// Ready wait, then the status read and change, the status wait and the final read.
+	want := []string{
+		"PUT /instances/rid",
+		"GET /instances/rid",
+		"GET /instances/rid",
+		"PUT /instances/rid/status",
+		"GET /instances/rid",
+		"GET /instances/rid",
+		"GET /instances/rid",
+	}
+	if strings.Join(requests, ",") != strings.Join(want, ",") {
+		t.Fatalf("unexpected requests:\n got: %v\nwant: %v", requests, want)
+	}
+
+	var got types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("status"), &got)...)
+	if got.ValueString() != "STOPPED" {
+		t.Fatalf("unexpected status in state: %q", got.ValueString())
+	}
+}
+
+func TestInstanceResource_Update_StatusAlreadyConvergedSkipsTransition(t *testing.T) {
+	var statusCalls int
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if strings.HasSuffix(r.URL.Path, "/status") {
+			statusCalls++
+		}
+		if r.Method == http.MethodPut {
+			return httpResponse(204, nil, ``), nil
+		}
+		return httpResponse(200, nil, `{"instance":{"resourceId":"rid","name":"n","endpoint":"https://e","status":"RUNNING"}}`), nil
+	}))
+
+	r := &instanceResource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
+	plan := instanceModel{
+		ResourceID:              types.StringValue("rid"),
+		Name:                    types.StringValue("n"),
+		ConfigurationResourceID: types.StringNull(),
+		InlineConfiguration:     jsonStringNull(),
+		Endpoint:                types.StringNull(),
+		Status:                  types.StringValue("RUNNING"),
+	}
+
+	var resp resource.UpdateResponse
+	initResourceState(t, &resp.State)
+	r.Update(context.Background(), resource.UpdateRequest{Plan: instancePlan(t, plan)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+	if statusCalls != 0 {
+		t.Fatalf("expected no status transition, got %d calls", statusCalls)
+	}
+}
+
+func TestInstanceResource_Update_RunningAliasIsConverged(t *testing.T) {
+	for _, reported := range []string{"READY", "ACTIVE"} {
+		var statusCalls int
+		c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(r.URL.Path, "/status") {
+				statusCalls++
+			}
+			if r.Method == http.MethodPut {
+				return httpResponse(204, nil, ``), nil
+			}
+			return httpResponse(200, nil, `{"instance":{"resourceId":"rid","name":"n","endpoint":"https://e","status":"`+reported+`"}}`), nil
+		}))
+
+		r := &instanceResource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
+		plan := instanceModel{
+			ResourceID:              types.StringValue("rid"),
+			Name:                    types.StringValue("n"),
+			ConfigurationResourceID: types.StringNull(),
+			InlineConfiguration:     jsonStringNull(),
+			Endpoint:                types.StringNull(),
+			Status:                  types.StringValue("RUNNING"),
+		}
+
+		var resp resource.UpdateResponse
+		initResourceState(t, &resp.State)
+		r.Update(context.Background(), resource.UpdateRequest{Plan: instancePlan(t, plan)}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: unexpected diagnostics: %#v", reported, resp.Diagnostics)
+		}
+		if statusCalls != 0 {
+			t.Fatalf("%s: expected no status transition, got %d calls", reported, statusCalls)
+		}
+		var got instanceModel
+		resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+		if got.Status.ValueString() != "RUNNING" {
+			t.Fatalf("%s: expected status RUNNING in state, got %q", reported, got.Status.ValueString())
+		}
+	}
+}
+
+func TestInstanceResource_Create_WaitsForEndpointBeforeReturning(t *testing.T) {
+	sleeps := stubInstanceSleep(t)
+
+	var gets int
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if r.Method == http.MethodPost {
+			return httpResponse(200, nil, `{}`), nil
+		}
+		gets++
+		if gets < 3 {
+			return httpResponse(200, nil, `{"instance":{"resourceId":"rid","name":"n","status":"PROVISIONING"}}`), nil
+		}
+		return httpResponse(200, nil, `{"instance":{"resourceId":"rid","name":"n","status":"RUNNING","endpoint":"https://e"}}`), nil
+	}))
+
+	r := &instanceResource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
+	plan := instanceModel{
+		ResourceID:              types.StringValue("rid"),
+		Name:                    types.StringValue("n"),
+		ConfigurationResourceID: types.StringNull(),
+		InlineConfiguration:     jsonStringNull(),
+		Endpoint:                types.StringUnknown(),
+		Status:                  types.StringUnknown(),
+		Timeouts:                timeoutsValue(map[string]string{"create": "1m"}),
+	}
+	config := instanceModel{ResourceID: types.StringValue("rid"), Endpoint: types.StringNull(), Status: types.StringNull()}
+
+	var resp resource.CreateResponse
+	initResourceState(t, &resp.State)
+	r.Create(context.Background(), resource.CreateRequest{Config: instanceConfig(t, config), Plan: instancePlan(t, plan)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+	if *sleeps != 2 {
+		t.Fatalf("expected 2 sleeps while waiting for readiness, got %d", *sleeps)
+	}
+
+	var endpoint types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("endpoint"), &endpoint)...)
+	if endpoint.ValueString() != "https://e" {
+		t.Fatalf("unexpected endpoint in state: %q", endpoint.ValueString())
+	}
+}
+
+func TestInstanceResource_Create_InvalidTimeoutAddsDiagnostics_NoHTTP(t *testing.T) {
+	var calls int
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		return httpResponse(500, nil, "unexpected"), nil
+	}))
+
+	r := &instanceResource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
+	plan := instanceModel{
+		ResourceID:              types.StringValue("rid"),
+		Name:                    types.StringValue("n"),
+		ConfigurationResourceID: types.StringNull(),
+		InlineConfiguration:     jsonStringNull(),
+		Endpoint:                types.StringUnknown(),
+		Status:                  types.StringNull(),
+		Timeouts:                timeoutsValue(map[string]string{"create": "soon"}),
+	}
+
+	var resp resource.CreateResponse
+	initResourceState(t, &resp.State)
+	r.Create(context.Background(), resource.CreateRequest{Config: instanceConfig(t, plan), Plan: instancePlan(t, plan)}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected diagnostics error")
+	}
+	if calls != 0 {
+		t.Fatalf("expected no http calls, got %d", calls)
+	}
+}
+
+func TestInstanceResource_Create_DrivesStatusTransitionWhenSet(t *testing.T) {
+	stubInstanceSleep(t)
+
+	var statusBody string
+	current := "RUNNING"
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		switch {
+		case r.Method == http.MethodPost:
+			return httpResponse(200, nil, `{}`), nil
+		case r.Method == http.MethodPut && strings.HasSuffix(r.URL.Path, "/status"):
+			b, _ := io.ReadAll(r.Body)
+			statusBody = string(b)
+			current = "SUSPENDED"
+			return httpResponse(202, nil, ``), nil
+		case r.Method == http.MethodGet:
+			return httpResponse(200, nil, `{"instance":{"resourceId":"rid","name":"n","endpoint":"https://e","status":"`+current+`"}}`), nil
+		default:
+			return httpResponse(500, nil, "unexpected"), nil
+		}
+	}))
+
+	r := &instanceResource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
+	plan := instanceModel{
+		ResourceID:              types.StringValue("rid"),
+		Name:                    types.StringValue("n"),
+		ConfigurationResourceID: types.StringNull(),
+		InlineConfiguration:     jsonStringNull(),
+		Endpoint:                types.StringUnknown(),
+		Status:                  types.StringValue("SUSPENDED"),
+	}
+	config := instanceModel{ResourceID: types.StringValue("rid"), Endpoint: types.StringNull(), Status: types.StringValue("SUSPENDED")}
+
+	var resp resource.CreateResponse
+	initResourceState(t, &resp.State)
+	r.Create(context.Background(), resource.CreateRequest{Config: instanceConfig(t, config), Plan: instancePlan(t, plan)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+	if statusBody != `{"status":"SUSPENDED"}` {
+		t.Fatalf("unexpected status body: %q", statusBody)
+	}
+}
+
+func TestInstanceResource_Update_StatusTransitionErrorAddsDiagnostics(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		switch {
+		case r.Method == http.MethodPut && strings.HasSuffix(r.URL.Path, "/status"):
+			return httpResponse(409, nil, `{"code":"InvalidTransition","message":"cannot stop"}`), nil
+		case r.Method == http.MethodPut:
+			return httpResponse(204, nil, ``), nil
+		default:
+			return httpResponse(200, nil, `{"instance":{"resourceId":"rid","name":"n","endpoint":"https://e","status":"RUNNING"}}`), nil
+		}
+	}))
+
+	r := &instanceResource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
+	plan := instanceModel{
+		ResourceID:              types.StringValue("rid"),
+		Name:                    types.StringValue("n"),
+		ConfigurationResourceID: types.StringNull(),
+		InlineConfiguration:     jsonStringNull(),
+		Endpoint:                types.StringNull(),
+		Status:                  types.StringValue("STOPPED"),
+	}
+
+	var resp resource.UpdateResponse
+	initResourceState(t, &resp.State)
+	r.Update(context.Background(), resource.UpdateRequest{Plan: instancePlan(t, plan)}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected diagnostics error")
+	}
+}
+
+func TestInstanceResource_Update_StatusInstanceMissingAddsDiagnostics(t *testing.T) {
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if r.Method == http.MethodPut {
+			return httpResponse(204, nil, ``), nil
+		}
+		return httpResponse(404, nil, `{"code":"NotFound","message":"missing"}`), nil
+	}))
+
+	r := &instanceResource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
+	plan := instanceModel{
+		ResourceID:              types.StringValue("rid"),
+		Name:                    types.StringValue("n"),
+		ConfigurationResourceID: types.StringNull(),
+		InlineConfiguration:     jsonStringNull(),
+		Endpoint:                types.StringNull(),
+		Status:                  types.StringValue("STOPPED"),
+	}
+
+	var resp resource.UpdateResponse
+	initResourceState(t, &resp.State)
+	r.Update(context.Background(), resource.UpdateRequest{Plan: instancePlan(t, plan)}, &resp)
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Instance not found" {
+		t.Fatalf("expected not found error, got %#v", resp.Diagnostics)
+	}
+}
+
 func TestInstanceResource_Read_NotFoundRemovesResource(t *testing.T) {
-	r := &instanceResource{baseURL: func(*APIClient) string { return "https://example.com" }}
+	r := &instanceResource{baseURL: func(*APIClient, string) string { return "https://example.com" }}
 	r.client = newTestClient(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
 		return httpResponse(404, nil, `{"code":"NotFound","message":"missing"}`), nil
 	}))
@@ -414,7 +724,7 @@ func TestInstanceResource_Read_NotFoundRemovesResource(t *testing.T) {
 }
 
 func TestInstanceResource_Read_EndpointEmptyMapsToNull(t *testing.T) {
-	r := &instanceResource{baseURL: func(*APIClient) string { return "https://example.com" }}
+	r := &instanceResource{baseURL: func(*APIClient, string) string { return "https://example.com" }}
 	r.client = newTestClient(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
 		if req.Method != http.MethodGet {
 			return httpResponse(500, nil, "unexpected"), nil
@@ -447,7 +757,7 @@ func TestInstanceResource_Read_EndpointEmptyMapsToNull(t *testing.T) {
 func TestInstanceResource_Read_EndpointUpdatesWhenAPIChanges(t *testing.T) {
 	var calls int
 
-	r := &instanceResource{baseURL: func(*APIClient) string { return "https://example.com" }}
+	r := &instanceResource{baseURL: func(*APIClient, string) string { return "https://example.com" }}
 	r.client = newTestClient(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
 		calls++
 		if req.Method != http.MethodGet {
@@ -521,7 +831,7 @@ func TestInstanceResource_Delete_DelegatesToDeleteInstance(t *testing.T) {
 	instanceSleepFn = func(_ time.Duration) {}
 	t.Cleanup(func() { instanceSleepFn = oldSleep })
 
-	r := &instanceResource{client: c, baseURL: func(*APIClient) string { return "https://example.com" }}
+	r := &instanceResource{client: c, baseURL: func(*APIClient, string) string { return "https://example.com" }}
 	state := instanceModel{ResourceID: types.StringValue("rid"), Name: types.StringValue("n"), Endpoint: types.StringNull()}
 
 	var resp resource.DeleteResponse
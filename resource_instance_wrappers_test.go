@@ -16,7 +16,7 @@ func TestInstanceWrappers_BaseURLWiring(t *testing.T) {
 		if r.baseURL == nil {
 			t.Fatalf("expected gateway baseURL function")
 		}
-		if got := r.baseURL(client); got != "https://gateway.management.eu.example.com" {
+		if got := r.baseURL(client, ""); got != "https://gateway.management.eu.example.com" {
 			t.Fatalf("unexpected gateway baseURL: %q", got)
 		}
 	}
@@ -26,7 +26,7 @@ func TestInstanceWrappers_BaseURLWiring(t *testing.T) {
 		if r.baseURL == nil {
 			t.Fatalf("expected authorizer baseURL function")
 		}
-		if got := r.baseURL(client); got != "https://authorizer.management.eu.example.com" {
+		if got := r.baseURL(client, ""); got != "https://authorizer.management.eu.example.com" {
 			t.Fatalf("unexpected authorizer baseURL: %q", got)
 		}
 	}
@@ -36,7 +36,7 @@ func TestInstanceWrappers_BaseURLWiring(t *testing.T) {
 		if r.baseURL == nil {
 			t.Fatalf("expected resolver baseURL function")
 		}
-		if got := r.baseURL(client); got != "https://resolver.management.eu.example.com" {
+		if got := r.baseURL(client, ""); got != "https://resolver.management.eu.example.com" {
 			t.Fatalf("unexpected resolver baseURL: %q", got)
 		}
 	}
@@ -46,7 +46,7 @@ func TestInstanceWrappers_BaseURLWiring(t *testing.T) {
 		if r.baseURL == nil {
 			t.Fatalf("expected validator baseURL function")
 		}
-		if got := r.baseURL(client); got != "https://validator.management.eu.example.com" {
+		if got := r.baseURL(client, ""); got != "https://validator.management.eu.example.com" {
 			t.Fatalf("unexpected validator baseURL: %q", got)
 		}
 	}
@@ -56,7 +56,7 @@ func TestInstanceWrappers_BaseURLWiring(t *testing.T) {
 		if r.baseURL == nil {
 			t.Fatalf("expected verifier baseURL function")
 		}
-		if got := r.baseURL(client); got != "https://verifier.management.eu.example.com" {
+		if got := r.baseURL(client, ""); got != "https://verifier.management.eu.example.com" {
 			t.Fatalf("unexpected verifier baseURL: %q", got)
 		}
 	}
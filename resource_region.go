@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// regionSchemaAttribute is the optional region of a regional (non-IAM) resource. Once created,
+// a resource stays pinned to its region even when the provider region changes.
+func regionSchemaAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Region the object is managed in (e.g. eu). Defaults to the provider region. Changing it forces a new resource.",
+		Validators:  []validator.String{regionValidator{}},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// resolveRegion returns the configured region, or the provider region when it is not set.
+func (c *APIClient) resolveRegion(region types.String) types.String {
+	if region.IsNull() || region.IsUnknown() || strings.TrimSpace(region.ValueString()) == "" {
+		return types.StringValue(c.cfg.defaultRegion)
+	}
+	return types.StringValue(strings.TrimSpace(region.ValueString()))
+}
+
+// importRegionalState accepts either <resource_id> (provider region) or <region>/<resource_id>.
+func importRegionalState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resourceID := req.ID
+	if region, id, ok := strings.Cut(req.ID, "/"); ok {
+		if region == "" || id == "" || !regionSlugRegex.MatchString(region) {
+			resp.Diagnostics.AddError("Invalid import ID", "Expected {resource_id} or {region}/{resource_id}, got "+req.ID)
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), region)...)
+		resourceID = id
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource_id"), resourceID)...)
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestImportRegionalState(t *testing.T) {
+	tests := []struct {
+		id         string
+		wantRegion types.String
+		wantID     string
+		wantErr    bool
+	}{
+		{id: "rid", wantRegion: types.StringNull(), wantID: "rid"},
+		{id: "us/rid", wantRegion: types.StringValue("us"), wantID: "rid"},
+		{id: "/rid", wantErr: true},
+		{id: "us/", wantErr: true},
+		{id: "US/rid", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		var resp resource.ImportStateResponse
+		initConfigurationState(t, &resp.State)
+
+		importRegionalState(context.Background(), resource.ImportStateRequest{ID: tt.id}, &resp)
+		if tt.wantErr {
+			if !resp.Diagnostics.HasError() {
+				t.Fatalf("%q: expected diagnostics error", tt.id)
+			}
+			continue
+		}
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%q: unexpected diagnostics: %#v", tt.id, resp.Diagnostics)
+		}
+
+		var got configurationModel
+		resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+		if got.ResourceID.ValueString() != tt.wantID || !got.Region.Equal(tt.wantRegion) {
+			t.Fatalf("%q: unexpected state: resource_id=%s region=%s", tt.id, got.ResourceID, got.Region)
+		}
+	}
+}
+
+func TestResolverConfigurationResource_Create_UsesResourceRegion(t *testing.T) {
+	var gotURLs []string
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		gotURLs = append(gotURLs, r.Method+" "+r.URL.String())
+		if r.Method == http.MethodGet {
+			return httpResponse(200, nil, `{"configuration":{"resourceId":"rid","name":"n","values":{}}}`), nil
+		}
+		return httpResponse(200, nil, `{}`), nil
+	}))
+
+	r := &ResolverConfigurationResource{client: c}
+	model := configurationModel{ResourceID: types.StringValue("rid"), Name: types.StringValue("n"), Values: jsonStringValue(`{}`), Region: types.StringValue("us")}
+
+	var resp resource.CreateResponse
+	initConfigurationState(t, &resp.State)
+	r.Create(context.Background(), resource.CreateRequest{Config: configurationConfig(t, model), Plan: configurationPlan(t, model)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+
+	want := []string{
+		"POST https://resolver.management.us.example.com/configurations",
+		"GET https://resolver.management.us.example.com/configurations/rid",
+	}
+	if len(gotURLs) != len(want) || gotURLs[0] != want[0] || gotURLs[1] != want[1] {
+		t.Fatalf("unexpected requests: %v", gotURLs)
+	}
+
+	var region types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("region"), &region)...)
+	if region.ValueString() != "us" {
+		t.Fatalf("unexpected region in state: %s", region)
+	}
+}
+
+func TestInstanceResource_RegionDefaultsToProviderRegion(t *testing.T) {
+	var gotURL string
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		gotURL = r.URL.String()
+		return httpResponse(200, nil, `{"instance":{"resourceId":"rid","name":"n","endpoint":"https://example.invalid"}}`), nil
+	}))
+	r := NewResolverInstanceResource().(*ResolverInstanceResource)
+	r.client = c
+
+	// State written before region existed has no region; reads go to the provider region
+	// and record it.
+	state := instanceModel{ResourceID: types.StringValue("rid"), Name: types.StringValue("n"), Region: types.StringNull()}
+	found, diags := r.read(context.Background(), &state)
+	if diags.HasError() || !found {
+		t.Fatalf("unexpected read result: %v %#v", found, diags)
+	}
+	if gotURL != "https://resolver.management.eu.example.com/instances/rid" {
+		t.Fatalf("unexpected url: %s", gotURL)
+	}
+	if state.Region.ValueString() != "eu" {
+		t.Fatalf("expected provider region recorded, got %s", state.Region)
+	}
+
+	state.Region = types.StringValue("us")
+	if _, diags := r.read(context.Background(), &state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", diags)
+	}
+	if gotURL != "https://resolver.management.us.example.com/instances/rid" {
+		t.Fatalf("expected read in the resource region, got %s", gotURL)
+	}
+}
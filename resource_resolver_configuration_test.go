@@ -51,8 +51,8 @@ func TestResolverConfigurationResource_Create_SendsExpectedPayload(t *testing.T)
 	}))
 
 	r := &ResolverConfigurationResource{client: c}
-	planModel := configurationModel{ResourceID: types.StringNull(), Name: types.StringValue("n"), Values: types.StringValue(`{"a":1}`)}
-	configModel := configurationModel{ResourceID: types.StringNull(), Name: types.StringValue("n"), Values: types.StringValue(`{"a":1}`)}
+	planModel := configurationModel{ResourceID: types.StringNull(), Name: types.StringValue("n"), Values: jsonStringValue(`{"a":1}`)}
+	configModel := configurationModel{ResourceID: types.StringNull(), Name: types.StringValue("n"), Values: jsonStringValue(`{"a":1}`)}
 
 	var req resource.CreateRequest
 	req.Plan = configurationPlan(t, planModel)
@@ -91,8 +91,8 @@ func TestResolverConfigurationResource_Create_InvalidValuesJSONAddsDiagnostics(t
 	}))
 
 	r := &ResolverConfigurationResource{client: c}
-	planModel := configurationModel{ResourceID: types.StringNull(), Name: types.StringValue("n"), Values: types.StringValue(`{bad json}`)}
-	configModel := configurationModel{ResourceID: types.StringNull(), Name: types.StringValue("n"), Values: types.StringValue(`{bad json}`)}
+	planModel := configurationModel{ResourceID: types.StringNull(), Name: types.StringValue("n"), Values: jsonStringValue(`{bad json}`)}
+	configModel := configurationModel{ResourceID: types.StringNull(), Name: types.StringValue("n"), Values: jsonStringValue(`{bad json}`)}
 
 	var req resource.CreateRequest
 	req.Plan = configurationPlan(t, planModel)
@@ -116,7 +116,7 @@ func TestResolverConfigurationResource_Read_NotFoundRemovesResource(t *testing.T
 	}))
 
 	r := &ResolverConfigurationResource{client: c}
-	stateModel := configurationModel{ResourceID: types.StringValue("rid"), Name: types.StringValue("n"), Values: types.StringValue(`{"a":1}`)}
+	stateModel := configurationModel{ResourceID: types.StringValue("rid"), Name: types.StringValue("n"), Values: jsonStringValue(`{"a":1}`)}
 
 	var req resource.ReadRequest
 	req.State = configurationState(t, stateModel)
@@ -154,7 +154,7 @@ func TestResolverConfigurationResource_Update_SendsExpectedPayload(t *testing.T)
 	}))
 
 	r := &ResolverConfigurationResource{client: c}
-	planModel := configurationModel{ResourceID: types.StringValue("rid"), Name: types.StringValue("n2"), Values: types.StringValue(`{"b":2}`)}
+	planModel := configurationModel{ResourceID: types.StringValue("rid"), Name: types.StringValue("n2"), Values: jsonStringValue(`{"b":2}`)}
 
 	var req resource.UpdateRequest
 	req.Plan = configurationPlan(t, planModel)
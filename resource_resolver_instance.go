@@ -16,8 +16,8 @@ type ResolverInstanceResource struct {
 func NewResolverInstanceResource() resource.Resource {
 	return &ResolverInstanceResource{
 		instanceResource: instanceResource{
-			baseURL: func(client *APIClient) string {
-				return client.resolverBaseURL()
+			baseURL: func(client *APIClient, region string) string {
+				return client.regionalBaseURL("resolver", region)
 			},
 		},
 	}
@@ -31,7 +31,7 @@ func (r *ResolverInstanceResource) Metadata(_ context.Context, req resource.Meta
 	resp.TypeName = req.ProviderTypeName + "_resolver_instance"
 }
 
-func (r *ResolverInstanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ResolverInstanceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"resource_id": schema.StringAttribute{
@@ -52,6 +52,7 @@ func (r *ResolverInstanceResource) Schema(_ context.Context, _ resource.SchemaRe
 				Description: "Resolver configuration resource ID to apply to this instance.",
 			},
 			"inline_configuration": schema.StringAttribute{
+				CustomType:  jsonStringType{},
 				Optional:    true,
 				Computed:    true,
 				Description: "Inline resolver configuration JSON (string). If omitted, the server may default this to an empty object.",
@@ -60,6 +61,11 @@ func (r *ResolverInstanceResource) Schema(_ context.Context, _ resource.SchemaRe
 				},
 			},
 			"endpoint": instanceEndpointSchemaAttribute(),
+			"status":   instanceStatusSchemaAttribute(),
+			"region":   regionSchemaAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
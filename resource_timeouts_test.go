@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestTimeoutContext_UsesConfiguredOrDefault(t *testing.T) {
+	var diags diag.Diagnostics
+	ctx, cancel := timeoutContext(context.Background(), &diags, timeoutsValue(map[string]string{"create": "90m"}).Create, defaultCreateTimeout)
+	defer cancel()
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", diags)
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok || time.Until(deadline) <= defaultCreateTimeout {
+		t.Fatalf("expected configured 90m deadline, got %v", time.Until(deadline))
+	}
+
+	ctx, cancel = timeoutContext(context.Background(), &diags, timeoutsValue(nil).Read, defaultReadTimeout)
+	defer cancel()
+	deadline, ok = ctx.Deadline()
+	if !ok || time.Until(deadline) > defaultReadTimeout {
+		t.Fatalf("expected default read deadline, got %v", time.Until(deadline))
+	}
+}
+
+func TestTimeoutContext_InvalidDurationAddsDiagnostics(t *testing.T) {
+	var diags diag.Diagnostics
+	ctx, cancel := timeoutContext(context.Background(), &diags, timeoutsValue(map[string]string{"delete": "later"}).Delete, defaultDeleteTimeout)
+	defer cancel()
+	if !diags.HasError() {
+		t.Fatalf("expected diagnostics error")
+	}
+	if _, ok := ctx.Deadline(); ok {
+		t.Fatalf("expected parent context to be returned unchanged")
+	}
+}
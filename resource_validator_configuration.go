@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type ValidatorConfigurationResource struct {
@@ -23,12 +22,14 @@ func NewValidatorConfigurationResource() resource.Resource {
 var _ resource.Resource = (*ValidatorConfigurationResource)(nil)
 var _ resource.ResourceWithConfigure = (*ValidatorConfigurationResource)(nil)
 var _ resource.ResourceWithImportState = (*ValidatorConfigurationResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*ValidatorConfigurationResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*ValidatorConfigurationResource)(nil)
 
 func (r *ValidatorConfigurationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_validator_configuration"
 }
 
-func (r *ValidatorConfigurationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ValidatorConfigurationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"resource_id": schema.StringAttribute{
@@ -45,9 +46,16 @@ func (r *ValidatorConfigurationResource) Schema(_ context.Context, _ resource.Sc
 				Description: "Human readable configuration name.",
 			},
 			"values": schema.StringAttribute{
-				Required:    true,
-				Description: "Validator configuration values JSON (string).",
+				CustomType:  jsonStringType{},
+				Optional:    true,
+				Computed:    true,
+				Description: "Validator configuration values JSON (string). Exactly one of values or settings must be set; when settings is used this is computed from it.",
 			},
+			"settings": validatorSettingsSchemaAttribute("Typed validator configuration, validated at plan time. Alternative to values."),
+			"region":   regionSchemaAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -59,23 +67,38 @@ func (r *ValidatorConfigurationResource) Configure(_ context.Context, req resour
 	r.client = req.ProviderData.(*APIClient)
 }
 
+func (r *ValidatorConfigurationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateSettingsConfig(ctx, req.Config, &resp.Diagnostics, "values", "settings", true)
+}
+
+func (r *ValidatorConfigurationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planJSONFromSettings(ctx, req, resp, "values", "settings")
+}
+
 func (r *ValidatorConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var config configurationModel
+	var config configurationSettingsModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var plan configurationModel
+	var plan configurationSettingsModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, plan.Timeouts.Create, defaultCreateTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resourceID, ok := resolveOrGenerateResourceID(&resp.Diagnostics, config.ResourceID, path.Root("resource_id"))
 	if !ok {
 		return
 	}
+	plan.Region = r.client.resolveRegion(plan.Region)
 
 	values := parseJSONToAny(&resp.Diagnostics, plan.Values.ValueString(), path.Root("values"), "values")
 	if resp.Diagnostics.HasError() {
@@ -83,7 +106,7 @@ func (r *ValidatorConfigurationResource) Create(ctx context.Context, req resourc
 	}
 
 	payload := configurationCreatePayload(resourceID, plan.Name.ValueString(), values)
-	resp.Diagnostics.Append(createConfiguration(ctx, r.client, r.client.validatorBaseURL(), payload)...)
+	resp.Diagnostics.Append(createConfiguration(ctx, r.client, r.client.regionalBaseURL("validator", plan.Region.ValueString()), payload)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -97,12 +120,20 @@ func (r *ValidatorConfigurationResource) Create(ctx context.Context, req resourc
 }
 
 func (r *ValidatorConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state configurationModel
+	var state configurationSettingsModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, state.Timeouts.Read, defaultReadTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// State written before region existed, or imported without one, is in the provider region.
+	state.Region = r.client.resolveRegion(state.Region)
 	resourceID := state.ResourceID.ValueString()
 	found, diags := r.readIntoState(ctx, resourceID, &state)
 	resp.Diagnostics.Append(diags...)
@@ -117,19 +148,26 @@ func (r *ValidatorConfigurationResource) Read(ctx context.Context, req resource.
 }
 
 func (r *ValidatorConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan configurationModel
+	var plan configurationSettingsModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, plan.Timeouts.Update, defaultUpdateTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resourceID := plan.ResourceID.ValueString()
+	plan.Region = r.client.resolveRegion(plan.Region)
 	values := parseJSONToAny(&resp.Diagnostics, plan.Values.ValueString(), path.Root("values"), "values")
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	payload := configurationUpdatePayload(plan.Name.ValueString(), values)
-	resp.Diagnostics.Append(updateConfiguration(ctx, r.client, r.client.validatorBaseURL(), resourceID, payload)...)
+	resp.Diagnostics.Append(updateConfiguration(ctx, r.client, r.client.regionalBaseURL("validator", plan.Region.ValueString()), resourceID, payload)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -143,22 +181,28 @@ func (r *ValidatorConfigurationResource) Update(ctx context.Context, req resourc
 }
 
 func (r *ValidatorConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state configurationModel
+	var state configurationSettingsModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, state.Timeouts.Delete, defaultDeleteTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resourceID := state.ResourceID.ValueString()
-	resp.Diagnostics.Append(deleteConfiguration(ctx, r.client, r.client.validatorBaseURL(), resourceID)...)
+	resp.Diagnostics.Append(deleteConfiguration(ctx, r.client, r.client.regionalBaseURL("validator", state.Region.ValueString()), resourceID)...)
 }
 
 func (r *ValidatorConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("resource_id"), req, resp)
+	importRegionalState(ctx, req, resp)
 }
 
-func (r *ValidatorConfigurationResource) readIntoState(ctx context.Context, resourceID string, state *configurationModel) (bool, diag.Diagnostics) {
-	found, out, valuesJSON, diags := readConfigurationIntoState(ctx, r.client, r.client.validatorBaseURL(), resourceID)
+func (r *ValidatorConfigurationResource) readIntoState(ctx context.Context, resourceID string, state *configurationSettingsModel) (bool, diag.Diagnostics) {
+	found, out, valuesJSON, diags := readConfigurationIntoState(ctx, r.client, r.client.regionalBaseURL("validator", state.Region.ValueString()), resourceID)
 	if diags.HasError() {
 		return false, diags
 	}
@@ -166,9 +210,7 @@ func (r *ValidatorConfigurationResource) readIntoState(ctx context.Context, reso
 		return false, diags
 	}
 
-	state.ResourceID = types.StringValue(out.Configuration.ResourceID)
-	state.Name = types.StringValue(out.Configuration.Name)
-	state.Values = types.StringValue(valuesJSON)
+	configurationResponseToModel(out, valuesJSON, &state.configurationModel)
 
 	return true, diags
 }
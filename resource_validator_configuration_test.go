@@ -51,15 +51,15 @@ func TestValidatorConfigurationResource_Create_SendsExpectedPayload(t *testing.T
 	}))
 
 	r := &ValidatorConfigurationResource{client: c}
-	planModel := configurationModel{ResourceID: types.StringNull(), Name: types.StringValue("n"), Values: types.StringValue(`{"a":1}`)}
-	configModel := configurationModel{ResourceID: types.StringNull(), Name: types.StringValue("n"), Values: types.StringValue(`{"a":1}`)}
+	planModel := configurationSettingsModel{configurationModel: configurationModel{ResourceID: types.StringNull(), Name: types.StringValue("n"), Values: jsonStringValue(`{"a":1}`)}}
+	configModel := configurationSettingsModel{configurationModel: configurationModel{ResourceID: types.StringNull(), Name: types.StringValue("n"), Values: jsonStringValue(`{"a":1}`)}}
 
 	var req resource.CreateRequest
-	req.Plan = configurationPlan(t, planModel)
-	req.Config = configurationConfig(t, configModel)
+	req.Plan = configurationSettingsPlan(t, validatorConfigurationSchema(), planModel)
+	req.Config = configurationSettingsConfig(t, validatorConfigurationSchema(), configModel)
 
 	var resp resource.CreateResponse
-	initConfigurationState(t, &resp.State)
+	initSchemaState(t, &resp.State, validatorConfigurationSchema())
 
 	oldRead := cryptoRandRead
 	cryptoRandRead = func(b []byte) (int, error) {
@@ -91,15 +91,15 @@ func TestValidatorConfigurationResource_Create_InvalidValuesJSONAddsDiagnostics(
 	}))
 
 	r := &ValidatorConfigurationResource{client: c}
-	planModel := configurationModel{ResourceID: types.StringNull(), Name: types.StringValue("n"), Values: types.StringValue(`{bad json}`)}
-	configModel := configurationModel{ResourceID: types.StringNull(), Name: types.StringValue("n"), Values: types.StringValue(`{bad json}`)}
+	planModel := configurationSettingsModel{configurationModel: configurationModel{ResourceID: types.StringNull(), Name: types.StringValue("n"), Values: jsonStringValue(`{bad json}`)}}
+	configModel := configurationSettingsModel{configurationModel: configurationModel{ResourceID: types.StringNull(), Name: types.StringValue("n"), Values: jsonStringValue(`{bad json}`)}}
 
 	var req resource.CreateRequest
-	req.Plan = configurationPlan(t, planModel)
-	req.Config = configurationConfig(t, configModel)
+	req.Plan = configurationSettingsPlan(t, validatorConfigurationSchema(), planModel)
+	req.Config = configurationSettingsConfig(t, validatorConfigurationSchema(), configModel)
 
 	var resp resource.CreateResponse
-	initConfigurationState(t, &resp.State)
+	initSchemaState(t, &resp.State, validatorConfigurationSchema())
 
 	r.Create(context.Background(), req, &resp)
 	if !resp.Diagnostics.HasError() {
@@ -116,13 +116,13 @@ func TestValidatorConfigurationResource_Read_NotFoundRemovesResource(t *testing.
 	}))
 
 	r := &ValidatorConfigurationResource{client: c}
-	stateModel := configurationModel{ResourceID: types.StringValue("rid"), Name: types.StringValue("n"), Values: types.StringValue(`{"a":1}`)}
+	stateModel := configurationSettingsModel{configurationModel: configurationModel{ResourceID: types.StringValue("rid"), Name: types.StringValue("n"), Values: jsonStringValue(`{"a":1}`)}}
 
 	var req resource.ReadRequest
-	req.State = configurationState(t, stateModel)
+	req.State = configurationSettingsState(t, validatorConfigurationSchema(), stateModel)
 
 	var resp resource.ReadResponse
-	initConfigurationState(t, &resp.State)
+	initSchemaState(t, &resp.State, validatorConfigurationSchema())
 
 	r.Read(context.Background(), req, &resp)
 	if resp.Diagnostics.HasError() {
@@ -154,13 +154,13 @@ func TestValidatorConfigurationResource_Update_SendsExpectedPayload(t *testing.T
 	}))
 
 	r := &ValidatorConfigurationResource{client: c}
-	planModel := configurationModel{ResourceID: types.StringValue("rid"), Name: types.StringValue("n2"), Values: types.StringValue(`{"b":2}`)}
+	planModel := configurationSettingsModel{configurationModel: configurationModel{ResourceID: types.StringValue("rid"), Name: types.StringValue("n2"), Values: jsonStringValue(`{"b":2}`)}}
 
 	var req resource.UpdateRequest
-	req.Plan = configurationPlan(t, planModel)
+	req.Plan = configurationSettingsPlan(t, validatorConfigurationSchema(), planModel)
 
 	var resp resource.UpdateResponse
-	initConfigurationState(t, &resp.State)
+	initSchemaState(t, &resp.State, validatorConfigurationSchema())
 
 	r.Update(context.Background(), req, &resp)
 	if resp.Diagnostics.HasError() {
@@ -192,10 +192,10 @@ func TestValidatorConfigurationResource_Delete_CallsDeleteConfiguration(t *testi
 	}))
 
 	r := &ValidatorConfigurationResource{client: c}
-	stateModel := configurationModel{ResourceID: types.StringValue("rid")}
+	stateModel := configurationSettingsModel{configurationModel: configurationModel{ResourceID: types.StringValue("rid")}}
 
 	var req resource.DeleteRequest
-	req.State = configurationState(t, stateModel)
+	req.State = configurationSettingsState(t, validatorConfigurationSchema(), stateModel)
 
 	var resp resource.DeleteResponse
 	r.Delete(context.Background(), req, &resp)
@@ -210,7 +210,7 @@ func TestValidatorConfigurationResource_Delete_CallsDeleteConfiguration(t *testi
 func TestValidatorConfigurationResource_ImportState_Passthrough(t *testing.T) {
 	r := &ValidatorConfigurationResource{}
 	var resp resource.ImportStateResponse
-	initConfigurationState(t, &resp.State)
+	initSchemaState(t, &resp.State, validatorConfigurationSchema())
 
 	r.ImportState(context.Background(), resource.ImportStateRequest{ID: "rid"}, &resp)
 	if resp.Diagnostics.HasError() {
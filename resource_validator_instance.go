@@ -10,14 +10,16 @@ import (
 )
 
 type ValidatorInstanceResource struct {
-	instanceResource
+	instanceSettingsResource
 }
 
 func NewValidatorInstanceResource() resource.Resource {
 	return &ValidatorInstanceResource{
-		instanceResource: instanceResource{
-			baseURL: func(client *APIClient) string {
-				return client.validatorBaseURL()
+		instanceSettingsResource: instanceSettingsResource{
+			instanceResource: instanceResource{
+				baseURL: func(client *APIClient, region string) string {
+					return client.regionalBaseURL("validator", region)
+				},
 			},
 		},
 	}
@@ -26,12 +28,14 @@ func NewValidatorInstanceResource() resource.Resource {
 var _ resource.Resource = (*ValidatorInstanceResource)(nil)
 var _ resource.ResourceWithConfigure = (*ValidatorInstanceResource)(nil)
 var _ resource.ResourceWithImportState = (*ValidatorInstanceResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*ValidatorInstanceResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*ValidatorInstanceResource)(nil)
 
 func (r *ValidatorInstanceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_validator_instance"
 }
 
-func (r *ValidatorInstanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ValidatorInstanceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"resource_id": schema.StringAttribute{
@@ -52,14 +56,21 @@ func (r *ValidatorInstanceResource) Schema(_ context.Context, _ resource.SchemaR
 				Description: "Validator configuration resource ID to apply to this instance.",
 			},
 			"inline_configuration": schema.StringAttribute{
+				CustomType:  jsonStringType{},
 				Optional:    true,
 				Computed:    true,
-				Description: "Inline validator configuration JSON (string). If omitted, the server may default this to an empty object.",
+				Description: "Inline validator configuration JSON (string). If omitted, the server may default this to an empty object. Conflicts with inline_settings; computed from it when that is used.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
-			"endpoint": instanceEndpointSchemaAttribute(),
+			"inline_settings": validatorSettingsSchemaAttribute("Typed inline validator configuration, validated at plan time. Alternative to inline_configuration."),
+			"endpoint":        instanceEndpointSchemaAttribute(),
+			"status":          instanceStatusSchemaAttribute(),
+			"region":          regionSchemaAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// validatorTrustedIssuerSettings builds typed validator settings from the given trust anchors,
+// each a {type, tag, pem} triple where empty strings are null.
+func validatorTrustedIssuerSettings(t *testing.T, anchors ...[3]string) types.Object {
+	t.Helper()
+	typ := validatorSettingsSchemaAttribute("").GetType().TerraformType(context.Background()).(tftypes.Object)
+	policiesType := typ.AttributeTypes["policies"].(tftypes.Object)
+	issuerType := policiesType.AttributeTypes["trusted_issuer"].(tftypes.Object)
+	anchorsType := issuerType.AttributeTypes["trusted_issuer_root_certificates"].(tftypes.List)
+	anchorType := anchorsType.ElementType.(tftypes.Object)
+
+	str := func(s string) tftypes.Value {
+		if s == "" {
+			return tftypes.NewValue(tftypes.String, nil)
+		}
+		return tftypes.NewValue(tftypes.String, s)
+	}
+	var elems []tftypes.Value
+	for _, a := range anchors {
+		elems = append(elems, tftypes.NewValue(anchorType, map[string]tftypes.Value{
+			"type": str(a[0]),
+			"tag":  str(a[1]),
+			"pem":  str(a[2]),
+		}))
+	}
+
+	raw := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"policies": tftypes.NewValue(policiesType, map[string]tftypes.Value{
+			"trusted_issuer": tftypes.NewValue(issuerType, map[string]tftypes.Value{
+				"skip":                             tftypes.NewValue(tftypes.Bool, false),
+				"trusted_issuer_root_certificates": tftypes.NewValue(anchorsType, elems),
+			}),
+		}),
+	})
+	out, err := validatorSettingsSchemaAttribute("").GetType().ValueFromTerraform(context.Background(), raw)
+	if err != nil {
+		t.Fatalf("settings ValueFromTerraform: %s", err)
+	}
+	return out.(types.Object)
+}
+
+func TestValidatorSettings_RenderTrustedIssuerDocument(t *testing.T) {
+	cert := testRootCertificatePEM(t)
+	settings := validatorTrustedIssuerSettings(t, [3]string{"predefined", "vidos", ""}, [3]string{"pem", "", cert})
+
+	got := structuredSettingsJSON(context.Background(), settings)
+	var doc map[string]any
+	if err := json.Unmarshal([]byte(got.ValueString()), &doc); err != nil {
+		t.Fatalf("invalid rendered json: %s", err)
+	}
+
+	want := map[string]any{
+		"policies": map[string]any{
+			"trustedIssuer": map[string]any{
+				"skip": false,
+				"trustedIssuerRootCertificates": []any{
+					map[string]any{"type": "predefined", "tag": "vidos"},
+					map[string]any{"type": "pem", "pem": cert},
+				},
+			},
+		},
+	}
+	wantJSON, _ := json.Marshal(want)
+	if !jsonSemanticallyContains(string(wantJSON), got.ValueString()) || !jsonSemanticallyContains(got.ValueString(), string(wantJSON)) {
+		t.Fatalf("unexpected document:\n got: %s\nwant: %s", got.ValueString(), wantJSON)
+	}
+}
+
+func TestValidatorInstanceResource_ValidateResourceConfig_RejectsMalformedPEM(t *testing.T) {
+	server, err := providerserver.NewProtocol6WithError(New())()
+	if err != nil {
+		t.Fatalf("provider server: %s", err)
+	}
+	s := validatorInstanceSchema()
+
+	validate := func(settings types.Object) *tfprotov6.ValidateResourceConfigResponse {
+		t.Helper()
+		raw := instanceSettingsRaw(t, s, instanceSettingsModel{
+			instanceModel:  instanceModel{Name: types.StringValue("n")},
+			InlineSettings: settings,
+		})
+		config, err := tfprotov6.NewDynamicValue(s.Type().TerraformType(context.Background()), raw)
+		if err != nil {
+			t.Fatalf("dynamic value: %s", err)
+		}
+		resp, err := server.ValidateResourceConfig(context.Background(), &tfprotov6.ValidateResourceConfigRequest{
+			TypeName: "vidos_validator_instance",
+			Config:   &config,
+		})
+		if err != nil {
+			t.Fatalf("ValidateResourceConfig: %s", err)
+		}
+		return resp
+	}
+
+	if resp := validate(validatorTrustedIssuerSettings(t, [3]string{"pem", "", testRootCertificatePEM(t)})); len(resp.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics for valid certificate: %#v", resp.Diagnostics[0])
+	}
+
+	resp := validate(validatorTrustedIssuerSettings(t, [3]string{"predefined", "vidos", ""}, [3]string{"pem", "", "-----BEGIN CERTIFICATE-----\nnot base64\n-----END CERTIFICATE-----"}))
+	if len(resp.Diagnostics) != 1 || resp.Diagnostics[0].Summary != "Invalid certificate" {
+		t.Fatalf("expected one invalid certificate diagnostic, got %#v", resp.Diagnostics)
+	}
+	wantPath := tftypes.NewAttributePath().
+		WithAttributeName("inline_settings").
+		WithAttributeName("policies").
+		WithAttributeName("trusted_issuer").
+		WithAttributeName("trusted_issuer_root_certificates").
+		WithElementKeyInt(1).
+		WithAttributeName("pem")
+	if !resp.Diagnostics[0].Attribute.Equal(wantPath) {
+		t.Fatalf("unexpected diagnostic path: %s", resp.Diagnostics[0].Attribute)
+	}
+}
+
+func TestValidatorConfigurationResource_ModifyPlan_RendersValuesFromSettings(t *testing.T) {
+	r := &ValidatorConfigurationResource{}
+	s := validatorConfigurationSchema()
+	model := configurationSettingsModel{
+		configurationModel: configurationModel{
+			ResourceID: types.StringUnknown(),
+			Name:       types.StringValue("n"),
+			Values:     jsonStringUnknown(),
+		},
+		Settings: validatorTrustedIssuerSettings(t, [3]string{"predefined", "vidos", ""}),
+	}
+
+	req := resource.ModifyPlanRequest{Plan: configurationSettingsPlan(t, s, model)}
+	initSchemaState(t, &req.State, s)
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+
+	r.ModifyPlan(context.Background(), req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+
+	var got jsonString
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(context.Background(), path.Root("values"), &got)...)
+	want := `{"policies":{"trustedIssuer":{"trustedIssuerRootCertificates":[{"type":"predefined","tag":"vidos"}]}}}`
+	if got.IsUnknown() || !jsonSemanticallyContains(want, got.ValueString()) {
+		t.Fatalf("expected values rendered from settings, got %s", got)
+	}
+}
+
+func TestValidatorConfigurationResource_ModifyPlan_DropsRemovedCertificateField(t *testing.T) {
+	r := &ValidatorConfigurationResource{}
+	s := validatorConfigurationSchema()
+	cert := testRootCertificatePEM(t)
+	prior := validatorTrustedIssuerSettings(t, [3]string{"pem", "root-a", cert})
+	state := configurationSettingsModel{
+		configurationModel: configurationModel{
+			ResourceID: types.StringValue("c1"),
+			Name:       types.StringValue("n"),
+			Values:     structuredSettingsJSON(context.Background(), prior),
+		},
+		Settings: prior,
+	}
+	plan := state
+	plan.Settings = validatorTrustedIssuerSettings(t, [3]string{"pem", "", cert})
+
+	req := resource.ModifyPlanRequest{Plan: configurationSettingsPlan(t, s, plan), State: configurationSettingsState(t, s, state)}
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(context.Background(), req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+
+	var got jsonString
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(context.Background(), path.Root("values"), &got)...)
+	want := structuredSettingsJSON(context.Background(), plan.Settings).ValueString()
+	if got.ValueString() != want || strings.Contains(got.ValueString(), "root-a") {
+		t.Fatalf("expected values without the removed tag, got %s", got)
+	}
+}
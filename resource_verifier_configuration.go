@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type VerifierConfigurationResource struct {
@@ -28,7 +27,7 @@ func (r *VerifierConfigurationResource) Metadata(_ context.Context, req resource
 	resp.TypeName = req.ProviderTypeName + "_verifier_configuration"
 }
 
-func (r *VerifierConfigurationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *VerifierConfigurationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"resource_id": schema.StringAttribute{
@@ -45,9 +44,14 @@ func (r *VerifierConfigurationResource) Schema(_ context.Context, _ resource.Sch
 				Description: "Human readable configuration name.",
 			},
 			"values": schema.StringAttribute{
+				CustomType:  jsonStringType{},
 				Required:    true,
 				Description: "Verifier configuration values JSON (string).",
 			},
+			"region": regionSchemaAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -72,10 +76,17 @@ func (r *VerifierConfigurationResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, plan.Timeouts.Create, defaultCreateTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resourceID, ok := resolveOrGenerateResourceID(&resp.Diagnostics, config.ResourceID, path.Root("resource_id"))
 	if !ok {
 		return
 	}
+	plan.Region = r.client.resolveRegion(plan.Region)
 
 	values := parseJSONToAny(&resp.Diagnostics, plan.Values.ValueString(), path.Root("values"), "values")
 	if resp.Diagnostics.HasError() {
@@ -83,7 +94,7 @@ func (r *VerifierConfigurationResource) Create(ctx context.Context, req resource
 	}
 
 	payload := configurationCreatePayload(resourceID, plan.Name.ValueString(), values)
-	resp.Diagnostics.Append(createConfiguration(ctx, r.client, r.client.verifierBaseURL(), payload)...)
+	resp.Diagnostics.Append(createConfiguration(ctx, r.client, r.client.regionalBaseURL("verifier", plan.Region.ValueString()), payload)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -103,6 +114,14 @@ func (r *VerifierConfigurationResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, state.Timeouts.Read, defaultReadTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// State written before region existed, or imported without one, is in the provider region.
+	state.Region = r.client.resolveRegion(state.Region)
 	resourceID := state.ResourceID.ValueString()
 	found, diags := r.readIntoState(ctx, resourceID, &state)
 	resp.Diagnostics.Append(diags...)
@@ -123,13 +142,20 @@ func (r *VerifierConfigurationResource) Update(ctx context.Context, req resource
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, plan.Timeouts.Update, defaultUpdateTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resourceID := plan.ResourceID.ValueString()
+	plan.Region = r.client.resolveRegion(plan.Region)
 	values := parseJSONToAny(&resp.Diagnostics, plan.Values.ValueString(), path.Root("values"), "values")
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	payload := configurationUpdatePayload(plan.Name.ValueString(), values)
-	resp.Diagnostics.Append(updateConfiguration(ctx, r.client, r.client.verifierBaseURL(), resourceID, payload)...)
+	resp.Diagnostics.Append(updateConfiguration(ctx, r.client, r.client.regionalBaseURL("verifier", plan.Region.ValueString()), resourceID, payload)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -149,16 +175,22 @@ func (r *VerifierConfigurationResource) Delete(ctx context.Context, req resource
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, &resp.Diagnostics, state.Timeouts.Delete, defaultDeleteTimeout)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resourceID := state.ResourceID.ValueString()
-	resp.Diagnostics.Append(deleteConfiguration(ctx, r.client, r.client.verifierBaseURL(), resourceID)...)
+	resp.Diagnostics.Append(deleteConfiguration(ctx, r.client, r.client.regionalBaseURL("verifier", state.Region.ValueString()), resourceID)...)
 }
 
 func (r *VerifierConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("resource_id"), req, resp)
+	importRegionalState(ctx, req, resp)
 }
 
 func (r *VerifierConfigurationResource) readIntoState(ctx context.Context, resourceID string, state *configurationModel) (bool, diag.Diagnostics) {
-	found, out, valuesJSON, diags := readConfigurationIntoState(ctx, r.client, r.client.verifierBaseURL(), resourceID)
+	found, out, valuesJSON, diags := readConfigurationIntoState(ctx, r.client, r.client.regionalBaseURL("verifier", state.Region.ValueString()), resourceID)
 	if diags.HasError() {
 		return false, diags
 	}
@@ -166,9 +198,7 @@ func (r *VerifierConfigurationResource) readIntoState(ctx context.Context, resou
 		return false, diags
 	}
 
-	state.ResourceID = types.StringValue(out.Configuration.ResourceID)
-	state.Name = types.StringValue(out.Configuration.Name)
-	state.Values = types.StringValue(valuesJSON)
+	configurationResponseToModel(out, valuesJSON, state)
 
 	return true, diags
 }
@@ -16,8 +16,8 @@ type VerifierInstanceResource struct {
 func NewVerifierInstanceResource() resource.Resource {
 	return &VerifierInstanceResource{
 		instanceResource: instanceResource{
-			baseURL: func(client *APIClient) string {
-				return client.verifierBaseURL()
+			baseURL: func(client *APIClient, region string) string {
+				return client.regionalBaseURL("verifier", region)
 			},
 		},
 	}
@@ -31,7 +31,7 @@ func (r *VerifierInstanceResource) Metadata(_ context.Context, req resource.Meta
 	resp.TypeName = req.ProviderTypeName + "_verifier_instance"
 }
 
-func (r *VerifierInstanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *VerifierInstanceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"resource_id": schema.StringAttribute{
@@ -52,6 +52,7 @@ func (r *VerifierInstanceResource) Schema(_ context.Context, _ resource.SchemaRe
 				Description: "Verifier configuration resource ID to apply to this instance.",
 			},
 			"inline_configuration": schema.StringAttribute{
+				CustomType:  jsonStringType{},
 				Optional:    true,
 				Computed:    true,
 				Description: "Inline verifier configuration JSON (string). If omitted, the server may default this to an empty object.",
@@ -60,6 +61,11 @@ func (r *VerifierInstanceResource) Schema(_ context.Context, _ resource.SchemaRe
 				},
 			},
 			"endpoint": instanceEndpointSchemaAttribute(),
+			"status":   instanceStatusSchemaAttribute(),
+			"region":   regionSchemaAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -13,8 +13,11 @@ func TestProvider_ResourcesAndDataSources(t *testing.T) {
 	if got := len(p.Resources(context.Background())); got == 0 {
 		t.Fatalf("expected resources")
 	}
-	if p.DataSources(context.Background()) != nil {
-		t.Fatalf("expected nil data sources")
+	if got := len(p.DataSources(context.Background())); got == 0 {
+		t.Fatalf("expected data sources")
+	}
+	if got := len(p.EphemeralResources(context.Background())); got == 0 {
+		t.Fatalf("expected ephemeral resources")
 	}
 }
 
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// retryPolicy controls how API requests are retried. The zero value is the default policy.
+type retryPolicy struct {
+	// maxRetries bounds retries per request; nil means defaultMaxAttempts-1. Retries also stop
+	// at the operation deadline.
+	maxRetries *int
+	// minBackoff and maxBackoff bound the exponential backoff; zero means the default.
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	// extraStatusCodes are additionally retried for idempotent methods, e.g. 500.
+	extraStatusCodes []int
+}
+
+// attemptsRemain reports whether the given attempt (starting at 1) may be made. A deadline
+// never adds attempts: a backend that keeps failing is given up on after the retry limit.
+func (p retryPolicy) attemptsRemain(ctx context.Context, attempt int) bool {
+	maxAttempts := defaultMaxAttempts
+	if p.maxRetries != nil {
+		maxAttempts = *p.maxRetries + 1
+	}
+	return ctx.Err() == nil && attempt <= maxAttempts
+}
+
+// retryable reports whether a response with status may be retried for method.
+func (p retryPolicy) retryable(method string, status int) bool {
+	if slices.Contains(retryableStatusCodes, status) {
+		return true
+	}
+	return isIdempotentMethod(method) && slices.Contains(p.extraStatusCodes, status)
+}
+
+func isIdempotentMethod(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter returns the positive wait a Retry-After header asks for, given in seconds
+// or as an HTTP date relative to now.
+func parseRetryAfter(retryAfter string, now time.Time) (time.Duration, bool) {
+	if retryAfter == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(strings.TrimSpace(retryAfter)); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(retryAfter); err == nil && !t.IsZero() && !now.IsZero() {
+		if d := t.Sub(now); d > 0 {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// sleep returns how long to wait before the next attempt, or false when the caller should
+// stop: the context is done or the wait would run past its deadline.
+func (p retryPolicy) sleep(ctx context.Context, attempt int, retryAfter string, now time.Time) (time.Duration, bool) {
+	if ctx.Err() != nil {
+		return 0, false
+	}
+	sleep := p.delay(attempt, retryAfter, now)
+	// Don't sleep past the deadline: the next attempt could not complete anyway.
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < sleep {
+		return 0, false
+	}
+	return sleep, true
+}
+
+// delay honors Retry-After (seconds or HTTP date) and otherwise backs off exponentially.
+func (p retryPolicy) delay(attempt int, retryAfter string, now time.Time) time.Duration {
+	if d, ok := parseRetryAfter(retryAfter, now); ok {
+		return d
+	}
+
+	// Exponential backoff with jitter. Capped to keep Terraform responsive.
+	base := p.minBackoff
+	if base <= 0 {
+		base = defaultRetryMinBackoff
+	}
+	max := p.maxBackoff
+	if max <= 0 {
+		max = defaultRetryMaxBackoff
+	}
+	// attempt starts at 1; stop doubling at the cap so long-running waiters don't overflow.
+	sleep := base
+	for i := 1; i < attempt && sleep < max; i++ {
+		sleep *= 2
+	}
+	// jitter in [0.5, 1.5)
+	jitter := 0.5 + rand.Float64()
+	sleep = time.Duration(float64(sleep) * jitter)
+	if sleep > max {
+		sleep = max
+	}
+	if floor := min(50*time.Millisecond, base); sleep < floor {
+		sleep = floor
+	}
+	return sleep
+}
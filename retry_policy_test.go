@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRetryPolicy_Retryable(t *testing.T) {
+	p := retryPolicy{extraStatusCodes: []int{500}}
+	tests := []struct {
+		method string
+		status int
+		want   bool
+	}{
+		{"POST", 429, true},
+		{"POST", 503, true},
+		{"GET", 500, true},
+		{"PUT", 500, true},
+		{"DELETE", 500, true},
+		{"POST", 500, false},
+		{"GET", 501, false},
+	}
+	for _, tt := range tests {
+		if got := p.retryable(tt.method, tt.status); got != tt.want {
+			t.Fatalf("%s %d: expected %v, got %v", tt.method, tt.status, tt.want, got)
+		}
+	}
+	if (retryPolicy{}).retryable("GET", 500) {
+		t.Fatalf("expected 500 not to be retried by default")
+	}
+}
+
+func TestRetryPolicy_MaxRetriesAppliesWithDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+
+	if !(retryPolicy{}).attemptsRemain(ctx, defaultMaxAttempts) || (retryPolicy{}).attemptsRemain(ctx, defaultMaxAttempts+1) {
+		t.Fatalf("expected default policy to stop after %d attempts under a deadline", defaultMaxAttempts)
+	}
+
+	two := 2
+	p := retryPolicy{maxRetries: &two}
+	if !p.attemptsRemain(ctx, 3) || p.attemptsRemain(ctx, 4) {
+		t.Fatalf("expected max_retries=2 to allow exactly 3 attempts")
+	}
+
+	zero := 0
+	if (retryPolicy{maxRetries: &zero}).attemptsRemain(ctx, 2) {
+		t.Fatalf("expected max_retries=0 to disable retries")
+	}
+}
+
+func TestRetryPolicy_DelayHonorsBackoffBounds(t *testing.T) {
+	p := retryPolicy{minBackoff: time.Second, maxBackoff: 3 * time.Second}
+	for i := 0; i < 50; i++ {
+		if d := p.delay(1, "", time.Time{}); d < 500*time.Millisecond || d > 1500*time.Millisecond {
+			t.Fatalf("unexpected first delay: %s", d)
+		}
+		if d := p.delay(10, "", time.Time{}); d < 1500*time.Millisecond || d > 3*time.Second {
+			t.Fatalf("unexpected capped delay: %s", d)
+		}
+	}
+	// The server's Retry-After wins over the cap.
+	if d := p.delay(1, "10", time.Time{}); d != 10*time.Second {
+		t.Fatalf("expected Retry-After delay, got %s", d)
+	}
+}
+
+func TestAPIClient_doJSONInternal_RetryPolicyFromConfig(t *testing.T) {
+	oldSleep := sleepFn
+	var sleeps []time.Duration
+	sleepFn = func(d time.Duration) { sleeps = append(sleeps, d) }
+	t.Cleanup(func() { sleepFn = oldSleep })
+
+	var calls int
+	c := newTestClient(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		return httpResponse(500, nil, `{"code":"Internal","message":"boom"}`), nil
+	}))
+	one := 1
+	c.cfg.retry = retryPolicy{maxRetries: &one, minBackoff: 2 * time.Second, maxBackoff: 2 * time.Second, extraStatusCodes: []int{500}}
+
+	if diags := c.doJSON(context.Background(), "GET", "https://example.com/x", nil, nil); !diags.HasError() {
+		t.Fatalf("expected error after retries")
+	}
+	if calls != 2 || len(sleeps) != 1 || sleeps[0] < time.Second || sleeps[0] > 2*time.Second {
+		t.Fatalf("expected one retry after at most 2s, got %d calls and sleeps %v", calls, sleeps)
+	}
+
+	calls = 0
+	if diags := c.doJSON(context.Background(), "POST", "https://example.com/x", map[string]any{}, nil); !diags.HasError() {
+		t.Fatalf("expected error")
+	}
+	if calls != 1 {
+		t.Fatalf("expected POST not to be retried on 500, got %d calls", calls)
+	}
+}
+
+func TestResolveRetryPolicy(t *testing.T) {
+	for _, env := range []string{"VIDOS_MAX_RETRIES", "VIDOS_RETRY_MIN_BACKOFF", "VIDOS_RETRY_MAX_BACKOFF"} {
+		t.Setenv(env, "")
+	}
+
+	policy, diags := resolveRetryPolicy(providerModel{})
+	if diags.HasError() || policy.maxRetries != nil || policy.minBackoff != 0 || policy.maxBackoff != 0 || policy.extraStatusCodes != nil {
+		t.Fatalf("expected default policy, got %#v %#v", policy, diags)
+	}
+
+	t.Setenv("VIDOS_MAX_RETRIES", "3")
+	t.Setenv("VIDOS_RETRY_MAX_BACKOFF", "1m")
+	policy, diags = resolveRetryPolicy(providerModel{
+		MaxRetries:           types.Int64Value(10),
+		RetryMinBackoff:      types.StringValue("500ms"),
+		RetryableStatusCodes: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(500)}),
+	})
+	if diags.HasError() || *policy.maxRetries != 10 || policy.minBackoff != 500*time.Millisecond || policy.maxBackoff != time.Minute ||
+		len(policy.extraStatusCodes) != 1 || policy.extraStatusCodes[0] != 500 {
+		t.Fatalf("unexpected policy: %#v %#v", policy, diags)
+	}
+
+	assertErrorAt := func(diags diag.Diagnostics, want path.Path) {
+		t.Helper()
+		if !diags.HasError() {
+			t.Fatalf("expected error at %s", want)
+		}
+		withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+		if !ok || !withPath.Path().Equal(want) {
+			t.Fatalf("expected error at %s, got %#v", want, diags.Errors()[0])
+		}
+	}
+
+	_, diags = resolveRetryPolicy(providerModel{RetryMinBackoff: types.StringValue("2m")})
+	assertErrorAt(diags, path.Root("retry_max_backoff"))
+
+	_, diags = resolveRetryPolicy(providerModel{RetryMinBackoff: types.StringValue("soon")})
+	assertErrorAt(diags, path.Root("retry_min_backoff"))
+
+	t.Setenv("VIDOS_MAX_RETRIES", "-1")
+	_, diags = resolveRetryPolicy(providerModel{})
+	assertErrorAt(diags, path.Root("max_retries"))
+}
@@ -0,0 +1,249 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Typed settings are nested-attribute alternatives to the raw JSON configuration attributes
+// (values / inline_configuration). Their shape mirrors the JSON document, with attribute
+// names in snake_case; the JSON attribute is computed from them so both forms stay in sync.
+
+// Enumerated values shared by the typed settings schemas; anything else is rejected at plan time.
+var (
+	settingsReferenceTypes    = []string{"instance"}
+	settingsServiceRoleOwners = []string{"managed", "account"}
+)
+
+// structuredToJSON converts a typed settings value into the JSON document the API expects.
+// Object attribute names become camelCase, map keys are kept verbatim and null attributes
+// are omitted. known is false when any part of the value is unknown.
+func structuredToJSON(ctx context.Context, v attr.Value) (any, bool) {
+	if v == nil || v.IsNull() {
+		return nil, true
+	}
+	if v.IsUnknown() {
+		return nil, false
+	}
+
+	switch tv := v.(type) {
+	case basetypes.ObjectValuable:
+		obj, diags := tv.ToObjectValue(ctx)
+		if diags.HasError() {
+			return nil, false
+		}
+		out := map[string]any{}
+		for name, av := range obj.Attributes() {
+			if av.IsNull() {
+				continue
+			}
+			converted, known := structuredToJSON(ctx, av)
+			if !known {
+				return nil, false
+			}
+			out[snakeToCamel(name)] = converted
+		}
+		return out, true
+	case basetypes.MapValue:
+		out := map[string]any{}
+		for key, ev := range tv.Elements() {
+			converted, known := structuredToJSON(ctx, ev)
+			if !known {
+				return nil, false
+			}
+			out[key] = converted
+		}
+		return out, true
+	case basetypes.ListValue:
+		return structuredElementsToJSON(ctx, tv.Elements())
+	case basetypes.SetValue:
+		return structuredElementsToJSON(ctx, tv.Elements())
+	case basetypes.StringValuable:
+		s, diags := tv.ToStringValue(ctx)
+		if diags.HasError() {
+			return nil, false
+		}
+		return s.ValueString(), true
+	case basetypes.BoolValue:
+		return tv.ValueBool(), true
+	case basetypes.Int64Value:
+		return tv.ValueInt64(), true
+	case basetypes.Float64Value:
+		return tv.ValueFloat64(), true
+	case basetypes.NumberValue:
+		f, _ := new(big.Float).Set(tv.ValueBigFloat()).Float64()
+		return f, true
+	default:
+		return nil, false
+	}
+}
+
+func structuredElementsToJSON(ctx context.Context, elements []attr.Value) (any, bool) {
+	out := make([]any, 0, len(elements))
+	for _, ev := range elements {
+		converted, known := structuredToJSON(ctx, ev)
+		if !known {
+			return nil, false
+		}
+		out = append(out, converted)
+	}
+	return out, true
+}
+
+// snakeToCamel converts a Terraform attribute name (resource_id) to its API key (resourceId).
+func snakeToCamel(s string) string {
+	parts := strings.Split(s, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] == "" {
+			continue
+		}
+		r := []rune(parts[i])
+		r[0] = unicode.ToUpper(r[0])
+		parts[i] = string(r)
+	}
+	return strings.Join(parts, "")
+}
+
+// structuredSettingsJSON renders typed settings as a jsonString: null when the settings are
+// null, unknown when any part is not yet known.
+func structuredSettingsJSON(ctx context.Context, settings attr.Value) jsonString {
+	if settings == nil || settings.IsNull() {
+		return jsonStringNull()
+	}
+	doc, known := structuredToJSON(ctx, settings)
+	if !known {
+		return jsonStringUnknown()
+	}
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return jsonStringUnknown()
+	}
+	return jsonStringValue(string(b))
+}
+
+// planStructuredJSON returns the planned value of the JSON attribute for typed settings. The
+// prior value is kept when it carries the rendered document and any extra keys in it can only
+// be server-populated defaults: either there are none, or the settings are unchanged since
+// prior was stored. Otherwise a removed setting would stay in the plan and never reach the API.
+func planStructuredJSON(ctx context.Context, settings, priorSettings attr.Value, prior jsonString) jsonString {
+	rendered := structuredSettingsJSON(ctx, settings)
+	if rendered.IsNull() || rendered.IsUnknown() || prior.IsNull() || prior.IsUnknown() {
+		return rendered
+	}
+	if !jsonSemanticallyContains(rendered.ValueString(), prior.ValueString()) {
+		return rendered
+	}
+	if jsonSemanticallyContains(prior.ValueString(), rendered.ValueString()) {
+		return prior
+	}
+	if priorRendered := structuredSettingsJSON(ctx, priorSettings); !priorRendered.IsUnknown() && priorRendered.ValueString() == rendered.ValueString() {
+		return prior
+	}
+	return rendered
+}
+
+// validateStructuredAlternative enforces that the raw JSON attribute and its typed
+// alternative are not both configured. When required is set, one of them must be.
+func validateStructuredAlternative(diags *diag.Diagnostics, jsonValue, settings attr.Value, jsonAttr, settingsAttr string, required bool) {
+	jsonSet := jsonValue != nil && !jsonValue.IsNull()
+	settingsSet := settings != nil && !settings.IsNull()
+
+	if jsonSet && settingsSet {
+		diags.AddAttributeError(
+			path.Root(settingsAttr),
+			"Conflicting configuration",
+			jsonAttr+" and "+settingsAttr+" are alternative forms of the same document; set only one of them.",
+		)
+		return
+	}
+	if required && !jsonSet && !settingsSet {
+		diags.AddAttributeError(
+			path.Root(jsonAttr),
+			"Missing configuration",
+			"One of "+jsonAttr+" or "+settingsAttr+" must be set.",
+		)
+	}
+}
+
+// validateSettingsConfig is the ValidateConfig body shared by resources with typed settings.
+func validateSettingsConfig(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics, jsonAttr, settingsAttr string, required bool) {
+	var jsonValue jsonString
+	var settings types.Object
+	diags.Append(config.GetAttribute(ctx, path.Root(jsonAttr), &jsonValue)...)
+	diags.Append(config.GetAttribute(ctx, path.Root(settingsAttr), &settings)...)
+	if diags.HasError() {
+		return
+	}
+
+	validateStructuredAlternative(diags, jsonValue, settings, jsonAttr, settingsAttr, required)
+}
+
+// planJSONFromSettings is the ModifyPlan body shared by resources with typed settings: when
+// the settings are configured, the JSON attribute is planned from them.
+func planJSONFromSettings(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, jsonAttr, settingsAttr string) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var settings types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(settingsAttr), &settings)...)
+	if resp.Diagnostics.HasError() || settings.IsNull() {
+		return
+	}
+
+	prior := jsonStringNull()
+	priorSettings := types.ObjectNull(settings.AttributeTypes(ctx))
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(jsonAttr), &prior)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(settingsAttr), &priorSettings)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(jsonAttr), planStructuredJSON(ctx, settings, priorSettings, prior))...)
+}
+
+// instanceReferenceSettingsAttributes describe a reference to another service instance, shared
+// by gateway routes and authorizer policies: {"type": "instance", "resourceId", "serviceRole"}.
+func instanceReferenceSettingsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"type": schema.StringAttribute{
+			Required:    true,
+			Description: "Target type. Currently only instance is supported.",
+			Validators:  []validator.String{stringOneOfValidator{values: settingsReferenceTypes}},
+		},
+		"resource_id": schema.StringAttribute{
+			Required:    true,
+			Description: "Resource ID of the target service instance.",
+		},
+		"service_role": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Service role used for service-to-service auth.",
+			Attributes: map[string]schema.Attribute{
+				"owner": schema.StringAttribute{
+					Required:    true,
+					Description: "Service role owner: managed or account.",
+					Validators:  []validator.String{stringOneOfValidator{values: settingsServiceRoleOwners}},
+				},
+				"resource_id": schema.StringAttribute{
+					Required:    true,
+					Description: "Service role resource ID (e.g. authorizer_all_actions).",
+				},
+			},
+		},
+	}
+}
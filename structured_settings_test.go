@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// authorizerGatewaySettings mirrors examples/gateway-authorizer in typed form.
+func authorizerGatewaySettings(t *testing.T) types.Object {
+	t.Helper()
+	typ := gatewaySettingsSchemaAttribute("").GetType().TerraformType(context.Background()).(tftypes.Object)
+	corsType := typ.AttributeTypes["cors"].(tftypes.Object)
+	pathsType := typ.AttributeTypes["paths"].(tftypes.Map)
+	pathType := pathsType.ElementType.(tftypes.Object)
+	roleType := pathType.AttributeTypes["service_role"].(tftypes.Object)
+	strList := tftypes.List{ElementType: tftypes.String}
+
+	cors := map[string]tftypes.Value{}
+	for name, at := range corsType.AttributeTypes {
+		cors[name] = tftypes.NewValue(at, nil)
+	}
+	cors["enabled"] = tftypes.NewValue(tftypes.Bool, true)
+	cors["origin"] = tftypes.NewValue(strList, []tftypes.Value{tftypes.NewValue(tftypes.String, "*")})
+	cors["allow_headers"] = tftypes.NewValue(strList, []tftypes.Value{tftypes.NewValue(tftypes.String, "*")})
+
+	return gatewaySettingsObject(t, map[string]tftypes.Value{
+		"cors": tftypes.NewValue(corsType, cors),
+		"paths": tftypes.NewValue(pathsType, map[string]tftypes.Value{
+			"auth": tftypes.NewValue(pathType, map[string]tftypes.Value{
+				"type":        tftypes.NewValue(tftypes.String, "instance"),
+				"service":     tftypes.NewValue(tftypes.String, "authorizer"),
+				"resource_id": tftypes.NewValue(tftypes.String, "auth-1"),
+				"service_role": tftypes.NewValue(roleType, map[string]tftypes.Value{
+					"owner":       tftypes.NewValue(tftypes.String, "managed"),
+					"resource_id": tftypes.NewValue(tftypes.String, "authorizer_all_actions"),
+				}),
+			}),
+		}),
+	})
+}
+
+const authorizerGatewayJSON = `{"cors":{"enabled":true,"origin":["*"],"allowHeaders":["*"]},"paths":{"auth":{"type":"instance","service":"authorizer","resourceId":"auth-1","serviceRole":{"owner":"managed","resourceId":"authorizer_all_actions"}}}}`
+
+func TestStructuredToJSON_MirrorsGatewayDocument(t *testing.T) {
+	got, known := structuredToJSON(context.Background(), authorizerGatewaySettings(t))
+	if !known {
+		t.Fatalf("expected known value")
+	}
+
+	var want any
+	if err := json.Unmarshal([]byte(authorizerGatewayJSON), &want); err != nil {
+		t.Fatalf("unmarshal: %s", err)
+	}
+	// Round-trip through JSON so numeric and slice types compare like the API payload.
+	b, _ := json.Marshal(got)
+	var gotDoc any
+	_ = json.Unmarshal(b, &gotDoc)
+	if !reflect.DeepEqual(gotDoc, want) {
+		t.Fatalf("unexpected document:\n got: %s\nwant: %s", b, authorizerGatewayJSON)
+	}
+}
+
+func TestStructuredSettingsJSON_NullAndUnknown(t *testing.T) {
+	ctx := context.Background()
+
+	if got := structuredSettingsJSON(ctx, gatewaySettingsNull()); !got.IsNull() {
+		t.Fatalf("expected null, got %s", got)
+	}
+
+	typ := gatewaySettingsSchemaAttribute("").GetType().TerraformType(ctx).(tftypes.Object)
+	settings := gatewaySettingsObject(t, map[string]tftypes.Value{
+		"paths": tftypes.NewValue(typ.AttributeTypes["paths"], tftypes.UnknownValue),
+	})
+	if got := structuredSettingsJSON(ctx, settings); !got.IsUnknown() {
+		t.Fatalf("expected unknown, got %s", got)
+	}
+}
+
+func TestSnakeToCamel(t *testing.T) {
+	tests := map[string]string{
+		"type":           "type",
+		"resource_id":    "resourceId",
+		"allow_headers":  "allowHeaders",
+		"trusted_a_b":    "trustedAB",
+		"trailing_":      "trailing",
+		"expose_headers": "exposeHeaders",
+	}
+	for in, want := range tests {
+		if got := snakeToCamel(in); got != want {
+			t.Fatalf("snakeToCamel(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestPlanStructuredJSON_KeepsPriorCarryingRenderedDocument(t *testing.T) {
+	ctx := context.Background()
+	settings := authorizerGatewaySettings(t)
+
+	// The server adds defaults; the prior value still carries everything rendered.
+	prior := jsonStringValue(`{"cors":{"enabled":true,"origin":["*"],"allowHeaders":["*"],"maxAge":600},"paths":{"auth":{"type":"instance","service":"authorizer","resourceId":"auth-1","serviceRole":{"owner":"managed","resourceId":"authorizer_all_actions"}}}}`)
+	if got := planStructuredJSON(ctx, settings, settings, prior); got.ValueString() != prior.ValueString() {
+		t.Fatalf("expected prior to be kept, got %s", got)
+	}
+
+	// Drift: the prior value no longer routes to auth-1.
+	drifted := jsonStringValue(`{"paths":{"auth":{"type":"instance","service":"authorizer","resourceId":"other"}}}`)
+	got := planStructuredJSON(ctx, settings, settings, drifted)
+	if !jsonSemanticallyContains(authorizerGatewayJSON, got.ValueString()) || got.ValueString() == drifted.ValueString() {
+		t.Fatalf("expected rendered document, got %s", got)
+	}
+
+	if got := planStructuredJSON(ctx, settings, gatewaySettingsNull(), jsonStringNull()); !jsonSemanticallyContains(authorizerGatewayJSON, got.ValueString()) {
+		t.Fatalf("expected rendered document without prior, got %s", got)
+	}
+}
+
+// withSettingsAttribute returns obj with one attribute replaced.
+func withSettingsAttribute(t *testing.T, obj types.Object, name string, value attr.Value) types.Object {
+	t.Helper()
+	attrs := map[string]attr.Value{}
+	for k, v := range obj.Attributes() {
+		attrs[k] = v
+	}
+	attrs[name] = value
+	out, diags := types.ObjectValue(obj.AttributeTypes(context.Background()), attrs)
+	if diags.HasError() {
+		t.Fatalf("ObjectValue: %#v", diags)
+	}
+	return out
+}
+
+func TestPlanStructuredJSON_RemovedSettingLeavesPlannedJSON(t *testing.T) {
+	ctx := context.Background()
+	settings := authorizerGatewaySettings(t)
+
+	// The prior settings also set cors.max_age and a second path; both were removed since.
+	cors := settings.Attributes()["cors"].(types.Object)
+	paths := settings.Attributes()["paths"].(types.Map)
+	priorPaths := map[string]attr.Value{}
+	for k, v := range paths.Elements() {
+		priorPaths[k] = v
+	}
+	priorPaths["admin"] = paths.Elements()["auth"]
+	priorSettings := withSettingsAttribute(t, settings, "cors", withSettingsAttribute(t, cors, "max_age", types.Int64Value(600)))
+	priorSettings = withSettingsAttribute(t, priorSettings, "paths", types.MapValueMust(paths.ElementType(ctx), priorPaths))
+	prior := structuredSettingsJSON(ctx, priorSettings)
+
+	got := planStructuredJSON(ctx, settings, priorSettings, prior)
+	if strings.Contains(got.ValueString(), "maxAge") || strings.Contains(got.ValueString(), `"admin"`) {
+		t.Fatalf("expected removed settings to leave the planned JSON, got %s", got)
+	}
+	if !jsonSemanticallyContains(authorizerGatewayJSON, got.ValueString()) || !jsonSemanticallyContains(got.ValueString(), authorizerGatewayJSON) {
+		t.Fatalf("expected rendered document, got %s", got)
+	}
+}
+
+func TestValidateStructuredAlternative(t *testing.T) {
+	set := jsonStringValue(`{}`)
+	unset := jsonStringNull()
+	settings := gatewaySettingsObject(t, nil)
+	noSettings := gatewaySettingsNull()
+
+	tests := []struct {
+		name     string
+		json     jsonString
+		settings types.Object
+		required bool
+		wantErr  string
+	}{
+		{"json only", set, noSettings, true, ""},
+		{"settings only", unset, settings, true, ""},
+		{"both", set, settings, false, "Conflicting configuration"},
+		{"neither required", unset, noSettings, true, "Missing configuration"},
+		{"neither optional", unset, noSettings, false, ""},
+		{"unknown json counts as set", jsonStringUnknown(), settings, false, "Conflicting configuration"},
+	}
+	for _, tc := range tests {
+		var diags diag.Diagnostics
+		validateStructuredAlternative(&diags, tc.json, tc.settings, "values", "settings", tc.required)
+		if tc.wantErr == "" {
+			if diags.HasError() {
+				t.Fatalf("%s: unexpected diagnostics: %#v", tc.name, diags)
+			}
+			continue
+		}
+		if !diags.HasError() || diags.Errors()[0].Summary() != tc.wantErr {
+			t.Fatalf("%s: expected %q, got %#v", tc.name, tc.wantErr, diags)
+		}
+	}
+}
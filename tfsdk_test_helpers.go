@@ -4,6 +4,10 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -16,160 +20,84 @@ func instanceSchema() schema.Schema {
 			"resource_id":               schema.StringAttribute{Optional: true, Computed: true},
 			"name":                      schema.StringAttribute{Required: true},
 			"configuration_resource_id": schema.StringAttribute{Optional: true},
-			"inline_configuration":      schema.StringAttribute{Optional: true, Computed: true},
+			"inline_configuration":      schema.StringAttribute{CustomType: jsonStringType{}, Optional: true, Computed: true},
 			"endpoint":                  schema.StringAttribute{Computed: true},
+			"status":                    schema.StringAttribute{Optional: true, Computed: true},
+			"region":                    schema.StringAttribute{Optional: true, Computed: true},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(context.Background()),
 		},
 	}
 }
 
-func instanceConfig(t *testing.T, v instanceModel) tfsdk.Config {
-	t.Helper()
-	ctx := context.Background()
-
-	s := instanceSchema()
-	attrTypes := map[string]tftypes.Type{
-		"resource_id":               tftypes.String,
-		"name":                      tftypes.String,
-		"configuration_resource_id": tftypes.String,
-		"inline_configuration":      tftypes.String,
-		"endpoint":                  tftypes.String,
-	}
+var resourceTimeoutsTFType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"create": tftypes.String,
+	"read":   tftypes.String,
+	"update": tftypes.String,
+	"delete": tftypes.String,
+}}
+
+// timeoutsValue builds a configured timeouts block; operations missing from ops are null.
+func timeoutsValue(ops map[string]string) timeouts.Value {
+	attrTypes := map[string]attr.Type{}
+	values := map[string]attr.Value{}
+	for _, op := range []string{"create", "read", "update", "delete"} {
+		attrTypes[op] = types.StringType
+		values[op] = types.StringNull()
+		if d, ok := ops[op]; ok {
+			values[op] = types.StringValue(d)
+		}
+	}
+	return timeouts.Value{Object: types.ObjectValueMust(attrTypes, values)}
+}
 
-	ridTF, err := v.ResourceID.ToTerraformValue(ctx)
-	if err != nil {
-		t.Fatalf("resource_id ToTerraformValue: %s", err)
-	}
-	nameTF, err := v.Name.ToTerraformValue(ctx)
-	if err != nil {
-		t.Fatalf("name ToTerraformValue: %s", err)
-	}
-	cidTF, err := v.ConfigurationResourceID.ToTerraformValue(ctx)
-	if err != nil {
-		t.Fatalf("configuration_resource_id ToTerraformValue: %s", err)
-	}
-	inlineTF, err := v.InlineConfiguration.ToTerraformValue(ctx)
-	if err != nil {
-		t.Fatalf("inline_configuration ToTerraformValue: %s", err)
+// resourceTimeoutsTF converts a timeouts value, treating the zero value as a null block.
+func resourceTimeoutsTF(t *testing.T, v timeouts.Value) tftypes.Value {
+	t.Helper()
+	if v.IsNull() {
+		return tftypes.NewValue(resourceTimeoutsTFType, nil)
 	}
-	endpointTF, err := v.Endpoint.ToTerraformValue(ctx)
+	out, err := v.ToTerraformValue(context.Background())
 	if err != nil {
-		t.Fatalf("endpoint ToTerraformValue: %s", err)
-	}
-
-	return tfsdk.Config{
-		Schema: s,
-		Raw: tftypes.NewValue(
-			tftypes.Object{AttributeTypes: attrTypes},
-			map[string]tftypes.Value{
-				"resource_id":               ridTF,
-				"name":                      nameTF,
-				"configuration_resource_id": cidTF,
-				"inline_configuration":      inlineTF,
-				"endpoint":                  endpointTF,
-			},
-		),
+		t.Fatalf("timeouts ToTerraformValue: %s", err)
 	}
+	return out
 }
 
-func instancePlan(t *testing.T, v instanceModel) tfsdk.Plan {
+func instanceRaw(t *testing.T, v instanceModel) tftypes.Value {
 	t.Helper()
-	ctx := context.Background()
-
-	s := instanceSchema()
-	attrTypes := map[string]tftypes.Type{
-		"resource_id":               tftypes.String,
-		"name":                      tftypes.String,
-		"configuration_resource_id": tftypes.String,
-		"inline_configuration":      tftypes.String,
-		"endpoint":                  tftypes.String,
-	}
+	return tftypes.NewValue(
+		instanceSchema().Type().TerraformType(context.Background()),
+		map[string]tftypes.Value{
+			"resource_id":               mustTerraformValue(t, v.ResourceID),
+			"name":                      mustTerraformValue(t, v.Name),
+			"configuration_resource_id": mustTerraformValue(t, v.ConfigurationResourceID),
+			"inline_configuration":      mustTerraformValue(t, v.InlineConfiguration),
+			"endpoint":                  mustTerraformValue(t, v.Endpoint),
+			"status":                    mustTerraformValue(t, v.Status),
+			"region":                    mustTerraformValue(t, v.Region),
+			"timeouts":                  resourceTimeoutsTF(t, v.Timeouts),
+		},
+	)
+}
 
-	ridTF, err := v.ResourceID.ToTerraformValue(ctx)
-	if err != nil {
-		t.Fatalf("resource_id ToTerraformValue: %s", err)
-	}
-	nameTF, err := v.Name.ToTerraformValue(ctx)
-	if err != nil {
-		t.Fatalf("name ToTerraformValue: %s", err)
-	}
-	cidTF, err := v.ConfigurationResourceID.ToTerraformValue(ctx)
-	if err != nil {
-		t.Fatalf("configuration_resource_id ToTerraformValue: %s", err)
-	}
-	inlineTF, err := v.InlineConfiguration.ToTerraformValue(ctx)
-	if err != nil {
-		t.Fatalf("inline_configuration ToTerraformValue: %s", err)
-	}
-	endpointTF, err := v.Endpoint.ToTerraformValue(ctx)
-	if err != nil {
-		t.Fatalf("endpoint ToTerraformValue: %s", err)
-	}
+func instanceConfig(t *testing.T, v instanceModel) tfsdk.Config {
+	t.Helper()
+	return tfsdk.Config{Schema: instanceSchema(), Raw: instanceRaw(t, v)}
+}
 
-	return tfsdk.Plan{
-		Schema: s,
-		Raw: tftypes.NewValue(
-			tftypes.Object{AttributeTypes: attrTypes},
-			map[string]tftypes.Value{
-				"resource_id":               ridTF,
-				"name":                      nameTF,
-				"configuration_resource_id": cidTF,
-				"inline_configuration":      inlineTF,
-				"endpoint":                  endpointTF,
-			},
-		),
-	}
+func instancePlan(t *testing.T, v instanceModel) tfsdk.Plan {
+	t.Helper()
+	return tfsdk.Plan{Schema: instanceSchema(), Raw: instanceRaw(t, v)}
 }
 
 func instanceState(t *testing.T, v instanceModel) tfsdk.State {
 	t.Helper()
-	ctx := context.Background()
-
-	s := instanceSchema()
-	attrTypes := map[string]tftypes.Type{
-		"resource_id":               tftypes.String,
-		"name":                      tftypes.String,
-		"configuration_resource_id": tftypes.String,
-		"inline_configuration":      tftypes.String,
-		"endpoint":                  tftypes.String,
-	}
-
-	ridTF, err := v.ResourceID.ToTerraformValue(ctx)
-	if err != nil {
-		t.Fatalf("resource_id ToTerraformValue: %s", err)
-	}
-	nameTF, err := v.Name.ToTerraformValue(ctx)
-	if err != nil {
-		t.Fatalf("name ToTerraformValue: %s", err)
-	}
-	cidTF, err := v.ConfigurationResourceID.ToTerraformValue(ctx)
-	if err != nil {
-		t.Fatalf("configuration_resource_id ToTerraformValue: %s", err)
-	}
-	inlineTF, err := v.InlineConfiguration.ToTerraformValue(ctx)
-	if err != nil {
-		t.Fatalf("inline_configuration ToTerraformValue: %s", err)
-	}
-	endpointTF, err := v.Endpoint.ToTerraformValue(ctx)
-	if err != nil {
-		t.Fatalf("endpoint ToTerraformValue: %s", err)
-	}
-
-	return tfsdk.State{
-		Schema: s,
-		Raw: tftypes.NewValue(
-			tftypes.Object{AttributeTypes: attrTypes},
-			map[string]tftypes.Value{
-				"resource_id":               ridTF,
-				"name":                      nameTF,
-				"configuration_resource_id": cidTF,
-				"inline_configuration":      inlineTF,
-				"endpoint":                  endpointTF,
-			},
-		),
-	}
+	return tfsdk.State{Schema: instanceSchema(), Raw: instanceRaw(t, v)}
 }
 
-func mustTerraformValue(t *testing.T, v types.String) tftypes.Value {
+func mustTerraformValue(t *testing.T, v attr.Value) tftypes.Value {
 	t.Helper()
 	out, err := v.ToTerraformValue(context.Background())
 	if err != nil {
@@ -183,139 +111,229 @@ func configurationSchema() schema.Schema {
 		Attributes: map[string]schema.Attribute{
 			"resource_id": schema.StringAttribute{Optional: true, Computed: true},
 			"name":        schema.StringAttribute{Required: true},
-			"values":      schema.StringAttribute{Required: true},
+			"values":      schema.StringAttribute{CustomType: jsonStringType{}, Required: true},
+			"region":      schema.StringAttribute{Optional: true, Computed: true},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(context.Background()),
 		},
 	}
 }
 
-func configurationConfig(t *testing.T, v configurationModel) tfsdk.Config {
+func configurationRaw(t *testing.T, v configurationModel) tftypes.Value {
 	t.Helper()
+	return tftypes.NewValue(
+		configurationSchema().Type().TerraformType(context.Background()),
+		map[string]tftypes.Value{
+			"resource_id": mustTerraformValue(t, v.ResourceID),
+			"name":        mustTerraformValue(t, v.Name),
+			"values":      mustTerraformValue(t, v.Values),
+			"region":      mustTerraformValue(t, v.Region),
+			"timeouts":    resourceTimeoutsTF(t, v.Timeouts),
+		},
+	)
+}
 
-	s := configurationSchema()
-	attrTypes := map[string]tftypes.Type{
-		"resource_id": tftypes.String,
-		"name":        tftypes.String,
-		"values":      tftypes.String,
-	}
-
-	return tfsdk.Config{
-		Schema: s,
-		Raw: tftypes.NewValue(
-			tftypes.Object{AttributeTypes: attrTypes},
-			map[string]tftypes.Value{
-				"resource_id": mustTerraformValue(t, v.ResourceID),
-				"name":        mustTerraformValue(t, v.Name),
-				"values":      mustTerraformValue(t, v.Values),
-			},
-		),
-	}
+func configurationConfig(t *testing.T, v configurationModel) tfsdk.Config {
+	t.Helper()
+	return tfsdk.Config{Schema: configurationSchema(), Raw: configurationRaw(t, v)}
 }
 
 func configurationPlan(t *testing.T, v configurationModel) tfsdk.Plan {
 	t.Helper()
+	return tfsdk.Plan{Schema: configurationSchema(), Raw: configurationRaw(t, v)}
+}
 
-	s := configurationSchema()
-	attrTypes := map[string]tftypes.Type{
-		"resource_id": tftypes.String,
-		"name":        tftypes.String,
-		"values":      tftypes.String,
+func configurationState(t *testing.T, v configurationModel) tfsdk.State {
+	t.Helper()
+	return tfsdk.State{Schema: configurationSchema(), Raw: configurationRaw(t, v)}
+}
+
+func resourceSchema(r resource.Resource) schema.Schema {
+	var resp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+func gatewayConfigurationSchema() schema.Schema {
+	return resourceSchema(NewGatewayConfigurationResource())
+}
+
+func gatewayInstanceSchema() schema.Schema {
+	return resourceSchema(NewGatewayInstanceResource())
+}
+
+func authorizerConfigurationSchema() schema.Schema {
+	return resourceSchema(NewAuthorizerConfigurationResource())
+}
+
+func authorizerInstanceSchema() schema.Schema {
+	return resourceSchema(NewAuthorizerInstanceResource())
+}
+
+func validatorConfigurationSchema() schema.Schema {
+	return resourceSchema(NewValidatorConfigurationResource())
+}
+
+func validatorInstanceSchema() schema.Schema {
+	return resourceSchema(NewValidatorInstanceResource())
+}
+
+func gatewaySettingsNull() types.Object {
+	return types.ObjectNull(gatewaySettingsSchemaAttribute("").GetType().(types.ObjectType).AttrTypes)
+}
+
+// gatewaySettingsObject builds typed gateway settings from a JSON-shaped literal of snake_case
+// attribute names; omitted attributes are null.
+func gatewaySettingsObject(t *testing.T, v map[string]tftypes.Value) types.Object {
+	t.Helper()
+	typ := gatewaySettingsSchemaAttribute("").GetType()
+	tfType := typ.TerraformType(context.Background()).(tftypes.Object)
+	full := map[string]tftypes.Value{}
+	for name, at := range tfType.AttributeTypes {
+		full[name] = tftypes.NewValue(at, nil)
+		if given, ok := v[name]; ok {
+			full[name] = given
+		}
+	}
+	out, err := typ.ValueFromTerraform(context.Background(), tftypes.NewValue(tfType, full))
+	if err != nil {
+		t.Fatalf("settings ValueFromTerraform: %s", err)
 	}
+	return out.(types.Object)
+}
 
-	return tfsdk.Plan{
-		Schema: s,
-		Raw: tftypes.NewValue(
-			tftypes.Object{AttributeTypes: attrTypes},
-			map[string]tftypes.Value{
-				"resource_id": mustTerraformValue(t, v.ResourceID),
-				"name":        mustTerraformValue(t, v.Name),
-				"values":      mustTerraformValue(t, v.Values),
-			},
-		),
+// settingsTF converts typed settings, treating a null value as a null of the schema's type.
+func settingsTF(t *testing.T, s schema.Schema, name string, v types.Object) tftypes.Value {
+	t.Helper()
+	if v.IsNull() {
+		return tftypes.NewValue(s.Attributes[name].GetType().TerraformType(context.Background()), nil)
 	}
+	return mustTerraformValue(t, v)
 }
 
-func configurationState(t *testing.T, v configurationModel) tfsdk.State {
+func configurationSettingsRaw(t *testing.T, s schema.Schema, v configurationSettingsModel) tftypes.Value {
 	t.Helper()
+	return tftypes.NewValue(
+		s.Type().TerraformType(context.Background()),
+		map[string]tftypes.Value{
+			"resource_id": mustTerraformValue(t, v.ResourceID),
+			"name":        mustTerraformValue(t, v.Name),
+			"values":      mustTerraformValue(t, v.Values),
+			"region":      mustTerraformValue(t, v.Region),
+			"settings":    settingsTF(t, s, "settings", v.Settings),
+			"timeouts":    resourceTimeoutsTF(t, v.Timeouts),
+		},
+	)
+}
 
-	s := configurationSchema()
-	attrTypes := map[string]tftypes.Type{
-		"resource_id": tftypes.String,
-		"name":        tftypes.String,
-		"values":      tftypes.String,
-	}
+func configurationSettingsConfig(t *testing.T, s schema.Schema, v configurationSettingsModel) tfsdk.Config {
+	t.Helper()
+	return tfsdk.Config{Schema: s, Raw: configurationSettingsRaw(t, s, v)}
+}
 
-	return tfsdk.State{
-		Schema: s,
-		Raw: tftypes.NewValue(
-			tftypes.Object{AttributeTypes: attrTypes},
-			map[string]tftypes.Value{
-				"resource_id": mustTerraformValue(t, v.ResourceID),
-				"name":        mustTerraformValue(t, v.Name),
-				"values":      mustTerraformValue(t, v.Values),
-			},
-		),
-	}
+func configurationSettingsPlan(t *testing.T, s schema.Schema, v configurationSettingsModel) tfsdk.Plan {
+	t.Helper()
+	return tfsdk.Plan{Schema: s, Raw: configurationSettingsRaw(t, s, v)}
 }
 
-func iamApiKeySchema() schema.Schema {
-	return schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"resource_id":            schema.StringAttribute{Computed: true},
-			"name":                   schema.StringAttribute{Required: true},
-			"inline_policy_document": schema.StringAttribute{Optional: true},
-			"api_secret":             schema.StringAttribute{Computed: true, Sensitive: true},
+func configurationSettingsState(t *testing.T, s schema.Schema, v configurationSettingsModel) tfsdk.State {
+	t.Helper()
+	return tfsdk.State{Schema: s, Raw: configurationSettingsRaw(t, s, v)}
+}
+
+func instanceSettingsRaw(t *testing.T, s schema.Schema, v instanceSettingsModel) tftypes.Value {
+	t.Helper()
+	return tftypes.NewValue(
+		s.Type().TerraformType(context.Background()),
+		map[string]tftypes.Value{
+			"resource_id":               mustTerraformValue(t, v.ResourceID),
+			"name":                      mustTerraformValue(t, v.Name),
+			"configuration_resource_id": mustTerraformValue(t, v.ConfigurationResourceID),
+			"inline_configuration":      mustTerraformValue(t, v.InlineConfiguration),
+			"inline_settings":           settingsTF(t, s, "inline_settings", v.InlineSettings),
+			"endpoint":                  mustTerraformValue(t, v.Endpoint),
+			"status":                    mustTerraformValue(t, v.Status),
+			"region":                    mustTerraformValue(t, v.Region),
+			"timeouts":                  resourceTimeoutsTF(t, v.Timeouts),
 		},
-	}
+	)
 }
 
-func iamApiKeyPlan(t *testing.T, v iamApiKeyModel) tfsdk.Plan {
+func instanceSettingsConfig(t *testing.T, s schema.Schema, v instanceSettingsModel) tfsdk.Config {
 	t.Helper()
+	return tfsdk.Config{Schema: s, Raw: instanceSettingsRaw(t, s, v)}
+}
 
-	s := iamApiKeySchema()
-	attrTypes := map[string]tftypes.Type{
-		"resource_id":            tftypes.String,
-		"name":                   tftypes.String,
-		"inline_policy_document": tftypes.String,
-		"api_secret":             tftypes.String,
-	}
+func instanceSettingsPlan(t *testing.T, s schema.Schema, v instanceSettingsModel) tfsdk.Plan {
+	t.Helper()
+	return tfsdk.Plan{Schema: s, Raw: instanceSettingsRaw(t, s, v)}
+}
 
-	return tfsdk.Plan{
-		Schema: s,
-		Raw: tftypes.NewValue(
-			tftypes.Object{AttributeTypes: attrTypes},
-			map[string]tftypes.Value{
-				"resource_id":            mustTerraformValue(t, v.ResourceID),
-				"name":                   mustTerraformValue(t, v.Name),
-				"inline_policy_document": mustTerraformValue(t, v.InlinePolicyDocument),
-				"api_secret":             mustTerraformValue(t, v.ApiSecret),
-			},
-		),
-	}
+func instanceSettingsState(t *testing.T, s schema.Schema, v instanceSettingsModel) tfsdk.State {
+	t.Helper()
+	return tfsdk.State{Schema: s, Raw: instanceSettingsRaw(t, s, v)}
 }
 
-func iamApiKeyState(t *testing.T, v iamApiKeyModel) tfsdk.State {
+// objectValue builds a tftypes object of typ, leaving attributes missing from vals null.
+func objectValue(typ tftypes.Object, vals map[string]tftypes.Value) tftypes.Value {
+	attrs := map[string]tftypes.Value{}
+	for name, at := range typ.AttributeTypes {
+		if v, ok := vals[name]; ok {
+			attrs[name] = v
+			continue
+		}
+		attrs[name] = tftypes.NewValue(at, nil)
+	}
+	return tftypes.NewValue(typ, attrs)
+}
+
+// initSchemaState prepares an empty response state for a resource schema.
+func initSchemaState(t *testing.T, st *tfsdk.State, s schema.Schema) {
 	t.Helper()
+	st.Schema = s
+	st.Raw = tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)
+}
 
-	s := iamApiKeySchema()
-	attrTypes := map[string]tftypes.Type{
-		"resource_id":            tftypes.String,
-		"name":                   tftypes.String,
-		"inline_policy_document": tftypes.String,
-		"api_secret":             tftypes.String,
-	}
+func iamApiKeySchema() schema.Schema {
+	return resourceSchema(NewIamApiKeyResource())
+}
 
-	return tfsdk.State{
-		Schema: s,
-		Raw: tftypes.NewValue(
-			tftypes.Object{AttributeTypes: attrTypes},
-			map[string]tftypes.Value{
-				"resource_id":            mustTerraformValue(t, v.ResourceID),
-				"name":                   mustTerraformValue(t, v.Name),
-				"inline_policy_document": mustTerraformValue(t, v.InlinePolicyDocument),
-				"api_secret":             mustTerraformValue(t, v.ApiSecret),
-			},
-		),
+// iamApiKeyRaw builds the raw value of an API key model; a zero rotation_triggers is null.
+func iamApiKeyRaw(t *testing.T, v iamApiKeyModel) tftypes.Value {
+	t.Helper()
+
+	triggers := v.RotationTriggers
+	if triggers.ElementType(context.Background()) == nil {
+		triggers = types.MapNull(types.StringType)
 	}
+
+	s := iamApiKeySchema()
+	return tftypes.NewValue(
+		s.Type().TerraformType(context.Background()),
+		map[string]tftypes.Value{
+			"resource_id":            mustTerraformValue(t, v.ResourceID),
+			"name":                   mustTerraformValue(t, v.Name),
+			"inline_policy_document": mustTerraformValue(t, v.InlinePolicyDocument),
+			"api_secret":             mustTerraformValue(t, v.ApiSecret),
+			"rotation_triggers":      mustTerraformValue(t, triggers),
+			"rotate_after":           mustTerraformValue(t, v.RotateAfter),
+			"rotation_grace_period":  mustTerraformValue(t, v.RotationGracePeriod),
+			"rotated_at":             mustTerraformValue(t, v.RotatedAt),
+			"rotation_due":           mustTerraformValue(t, v.RotationDue),
+			"timeouts":               resourceTimeoutsTF(t, v.Timeouts),
+		},
+	)
+}
+
+func iamApiKeyPlan(t *testing.T, v iamApiKeyModel) tfsdk.Plan {
+	t.Helper()
+	return tfsdk.Plan{Schema: iamApiKeySchema(), Raw: iamApiKeyRaw(t, v)}
+}
+
+func iamApiKeyState(t *testing.T, v iamApiKeyModel) tfsdk.State {
+	t.Helper()
+	return tfsdk.State{Schema: iamApiKeySchema(), Raw: iamApiKeyRaw(t, v)}
 }
 
 func iamApiKeyPolicyAttachmentSchema() schema.Schema {
@@ -326,6 +344,9 @@ func iamApiKeyPolicyAttachmentSchema() schema.Schema {
 			"policy_type": schema.StringAttribute{Required: true},
 			"policy_id":   schema.StringAttribute{Required: true},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(context.Background()),
+		},
 	}
 }
 
@@ -338,6 +359,7 @@ func iamApiKeyPolicyAttachmentPlan(t *testing.T, v iamApiKeyPolicyAttachmentMode
 		"api_key_id":  tftypes.String,
 		"policy_type": tftypes.String,
 		"policy_id":   tftypes.String,
+		"timeouts":    resourceTimeoutsTFType,
 	}
 
 	return tfsdk.Plan{
@@ -349,6 +371,7 @@ func iamApiKeyPolicyAttachmentPlan(t *testing.T, v iamApiKeyPolicyAttachmentMode
 				"api_key_id":  mustTerraformValue(t, v.ApiKeyID),
 				"policy_type": mustTerraformValue(t, v.PolicyType),
 				"policy_id":   mustTerraformValue(t, v.PolicyID),
+				"timeouts":    resourceTimeoutsTF(t, v.Timeouts),
 			},
 		),
 	}
@@ -363,6 +386,7 @@ func iamApiKeyPolicyAttachmentState(t *testing.T, v iamApiKeyPolicyAttachmentMod
 		"api_key_id":  tftypes.String,
 		"policy_type": tftypes.String,
 		"policy_id":   tftypes.String,
+		"timeouts":    resourceTimeoutsTFType,
 	}
 
 	return tfsdk.State{
@@ -374,6 +398,7 @@ func iamApiKeyPolicyAttachmentState(t *testing.T, v iamApiKeyPolicyAttachmentMod
 				"api_key_id":  mustTerraformValue(t, v.ApiKeyID),
 				"policy_type": mustTerraformValue(t, v.PolicyType),
 				"policy_id":   mustTerraformValue(t, v.PolicyID),
+				"timeouts":    resourceTimeoutsTF(t, v.Timeouts),
 			},
 		),
 	}
@@ -384,7 +409,10 @@ func iamPolicySchema() schema.Schema {
 		Attributes: map[string]schema.Attribute{
 			"resource_id": schema.StringAttribute{Optional: true, Computed: true},
 			"name":        schema.StringAttribute{Required: true},
-			"document":    schema.StringAttribute{Required: true},
+			"document":    schema.StringAttribute{CustomType: jsonStringType{}, Required: true},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(context.Background()),
 		},
 	}
 }
@@ -397,6 +425,7 @@ func iamPolicyConfig(t *testing.T, v iamPolicyModel) tfsdk.Config {
 		"resource_id": tftypes.String,
 		"name":        tftypes.String,
 		"document":    tftypes.String,
+		"timeouts":    resourceTimeoutsTFType,
 	}
 
 	return tfsdk.Config{
@@ -407,6 +436,7 @@ func iamPolicyConfig(t *testing.T, v iamPolicyModel) tfsdk.Config {
 				"resource_id": mustTerraformValue(t, v.ResourceID),
 				"name":        mustTerraformValue(t, v.Name),
 				"document":    mustTerraformValue(t, v.Document),
+				"timeouts":    resourceTimeoutsTF(t, v.Timeouts),
 			},
 		),
 	}
@@ -420,6 +450,7 @@ func iamPolicyPlan(t *testing.T, v iamPolicyModel) tfsdk.Plan {
 		"resource_id": tftypes.String,
 		"name":        tftypes.String,
 		"document":    tftypes.String,
+		"timeouts":    resourceTimeoutsTFType,
 	}
 
 	return tfsdk.Plan{
@@ -430,6 +461,7 @@ func iamPolicyPlan(t *testing.T, v iamPolicyModel) tfsdk.Plan {
 				"resource_id": mustTerraformValue(t, v.ResourceID),
 				"name":        mustTerraformValue(t, v.Name),
 				"document":    mustTerraformValue(t, v.Document),
+				"timeouts":    resourceTimeoutsTF(t, v.Timeouts),
 			},
 		),
 	}
@@ -443,6 +475,7 @@ func iamPolicyState(t *testing.T, v iamPolicyModel) tfsdk.State {
 		"resource_id": tftypes.String,
 		"name":        tftypes.String,
 		"document":    tftypes.String,
+		"timeouts":    resourceTimeoutsTFType,
 	}
 
 	return tfsdk.State{
@@ -453,6 +486,7 @@ func iamPolicyState(t *testing.T, v iamPolicyModel) tfsdk.State {
 				"resource_id": mustTerraformValue(t, v.ResourceID),
 				"name":        mustTerraformValue(t, v.Name),
 				"document":    mustTerraformValue(t, v.Document),
+				"timeouts":    resourceTimeoutsTF(t, v.Timeouts),
 			},
 		),
 	}
@@ -463,7 +497,10 @@ func iamServiceRoleSchema() schema.Schema {
 		Attributes: map[string]schema.Attribute{
 			"resource_id":            schema.StringAttribute{Optional: true, Computed: true},
 			"name":                   schema.StringAttribute{Required: true},
-			"inline_policy_document": schema.StringAttribute{Optional: true},
+			"inline_policy_document": schema.StringAttribute{CustomType: jsonStringType{}, Optional: true},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(context.Background()),
 		},
 	}
 }
@@ -476,6 +513,7 @@ func iamServiceRoleConfig(t *testing.T, v iamServiceRoleModel) tfsdk.Config {
 		"resource_id":            tftypes.String,
 		"name":                   tftypes.String,
 		"inline_policy_document": tftypes.String,
+		"timeouts":               resourceTimeoutsTFType,
 	}
 
 	return tfsdk.Config{
@@ -486,6 +524,7 @@ func iamServiceRoleConfig(t *testing.T, v iamServiceRoleModel) tfsdk.Config {
 				"resource_id":            mustTerraformValue(t, v.ResourceID),
 				"name":                   mustTerraformValue(t, v.Name),
 				"inline_policy_document": mustTerraformValue(t, v.InlinePolicyDocument),
+				"timeouts":               resourceTimeoutsTF(t, v.Timeouts),
 			},
 		),
 	}
@@ -499,6 +538,7 @@ func iamServiceRolePlan(t *testing.T, v iamServiceRoleModel) tfsdk.Plan {
 		"resource_id":            tftypes.String,
 		"name":                   tftypes.String,
 		"inline_policy_document": tftypes.String,
+		"timeouts":               resourceTimeoutsTFType,
 	}
 
 	return tfsdk.Plan{
@@ -509,6 +549,7 @@ func iamServiceRolePlan(t *testing.T, v iamServiceRoleModel) tfsdk.Plan {
 				"resource_id":            mustTerraformValue(t, v.ResourceID),
 				"name":                   mustTerraformValue(t, v.Name),
 				"inline_policy_document": mustTerraformValue(t, v.InlinePolicyDocument),
+				"timeouts":               resourceTimeoutsTF(t, v.Timeouts),
 			},
 		),
 	}
@@ -522,6 +563,7 @@ func iamServiceRoleState(t *testing.T, v iamServiceRoleModel) tfsdk.State {
 		"resource_id":            tftypes.String,
 		"name":                   tftypes.String,
 		"inline_policy_document": tftypes.String,
+		"timeouts":               resourceTimeoutsTFType,
 	}
 
 	return tfsdk.State{
@@ -532,6 +574,7 @@ func iamServiceRoleState(t *testing.T, v iamServiceRoleModel) tfsdk.State {
 				"resource_id":            mustTerraformValue(t, v.ResourceID),
 				"name":                   mustTerraformValue(t, v.Name),
 				"inline_policy_document": mustTerraformValue(t, v.InlinePolicyDocument),
+				"timeouts":               resourceTimeoutsTF(t, v.Timeouts),
 			},
 		),
 	}
@@ -545,6 +588,9 @@ func iamServiceRolePolicyAttachmentSchema() schema.Schema {
 			"policy_type":     schema.StringAttribute{Required: true},
 			"policy_id":       schema.StringAttribute{Required: true},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(context.Background()),
+		},
 	}
 }
 
@@ -557,6 +603,7 @@ func iamServiceRolePolicyAttachmentPlan(t *testing.T, v iamServiceRolePolicyAtta
 		"service_role_id": tftypes.String,
 		"policy_type":     tftypes.String,
 		"policy_id":       tftypes.String,
+		"timeouts":        resourceTimeoutsTFType,
 	}
 
 	return tfsdk.Plan{
@@ -568,6 +615,7 @@ func iamServiceRolePolicyAttachmentPlan(t *testing.T, v iamServiceRolePolicyAtta
 				"service_role_id": mustTerraformValue(t, v.ServiceRoleID),
 				"policy_type":     mustTerraformValue(t, v.PolicyType),
 				"policy_id":       mustTerraformValue(t, v.PolicyID),
+				"timeouts":        resourceTimeoutsTF(t, v.Timeouts),
 			},
 		),
 	}
@@ -582,6 +630,7 @@ func iamServiceRolePolicyAttachmentState(t *testing.T, v iamServiceRolePolicyAtt
 		"service_role_id": tftypes.String,
 		"policy_type":     tftypes.String,
 		"policy_id":       tftypes.String,
+		"timeouts":        resourceTimeoutsTFType,
 	}
 
 	return tfsdk.State{
@@ -593,7 +642,41 @@ func iamServiceRolePolicyAttachmentState(t *testing.T, v iamServiceRolePolicyAtt
 				"service_role_id": mustTerraformValue(t, v.ServiceRoleID),
 				"policy_type":     mustTerraformValue(t, v.PolicyType),
 				"policy_id":       mustTerraformValue(t, v.PolicyID),
+				"timeouts":        resourceTimeoutsTF(t, v.Timeouts),
 			},
 		),
 	}
 }
+
+// dataSourceConfig builds a data source config from the given schema. Attributes missing
+// from values are set to null.
+func dataSourceConfig(t *testing.T, s dsschema.Schema, values map[string]attr.Value) tfsdk.Config {
+	t.Helper()
+	ctx := context.Background()
+
+	objType := s.Type().TerraformType(ctx).(tftypes.Object)
+	raw := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, typ := range objType.AttributeTypes {
+		v, ok := values[name]
+		if !ok {
+			raw[name] = tftypes.NewValue(typ, nil)
+			continue
+		}
+		tv, err := v.ToTerraformValue(ctx)
+		if err != nil {
+			t.Fatalf("%s ToTerraformValue: %s", name, err)
+		}
+		raw[name] = tv
+	}
+
+	return tfsdk.Config{
+		Schema: s,
+		Raw:    tftypes.NewValue(objType, raw),
+	}
+}
+
+func initDataSourceState(t *testing.T, st *tfsdk.State, s dsschema.Schema) {
+	t.Helper()
+	st.Schema = s
+	st.Raw = tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// transportConfig holds the provider's TLS and proxy settings. The zero value means the
+// default transport, which already honors HTTPS_PROXY/NO_PROXY.
+type transportConfig struct {
+	// caBundlePEM is trusted in addition to the system roots.
+	caBundlePEM []byte
+	// clientCertPEM and clientKeyPEM form the optional mTLS client certificate.
+	clientCertPEM []byte
+	clientKeyPEM  []byte
+	// proxyURL replaces HTTPS_PROXY/HTTP_PROXY; NO_PROXY still applies.
+	proxyURL string
+	// insecure skips certificate verification, except for hosts under the default domain.
+	insecure bool
+}
+
+func (t transportConfig) isZero() bool {
+	return len(t.caBundlePEM) == 0 && len(t.clientCertPEM) == 0 && len(t.clientKeyPEM) == 0 && t.proxyURL == "" && !t.insecure
+}
+
+// newHTTPTransport builds a transport from cfg on top of http.DefaultTransport's settings.
+func newHTTPTransport(cfg transportConfig) (*http.Transport, error) {
+	base, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		base = &http.Transport{Proxy: http.ProxyFromEnvironment}
+	}
+	transport := base.Clone()
+
+	tlsConfig, err := newTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	if cfg.proxyURL != "" {
+		proxyConfig := &httpproxy.Config{
+			HTTPProxy:  cfg.proxyURL,
+			HTTPSProxy: cfg.proxyURL,
+			NoProxy:    firstNonEmpty(os.Getenv("NO_PROXY"), os.Getenv("no_proxy")),
+		}
+		proxy := proxyConfig.ProxyFunc()
+		transport.Proxy = func(req *http.Request) (*url.URL, error) {
+			return proxy(req.URL)
+		}
+	}
+	return transport, nil
+}
+
+func newTLSConfig(cfg transportConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if len(cfg.caBundlePEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(cfg.caBundlePEM) {
+			return nil, errors.New("CA bundle contains no PEM certificates")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if len(cfg.clientCertPEM) > 0 || len(cfg.clientKeyPEM) > 0 {
+		if len(cfg.clientCertPEM) == 0 || len(cfg.clientKeyPEM) == 0 {
+			return nil, errors.New("client certificate and client key must be set together")
+		}
+		cert, err := tls.X509KeyPair(cfg.clientCertPEM, cfg.clientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if cfg.insecure {
+		// Verification is done in VerifyConnection instead, so it can still be enforced for
+		// the public Vidos endpoints.
+		tlsConfig.InsecureSkipVerify = true
+		roots := tlsConfig.RootCAs
+		tlsConfig.VerifyConnection = func(cs tls.ConnectionState) error {
+			if !isDefaultDomainHost(cs.ServerName) {
+				return nil
+			}
+			return verifyPeerCertificates(cs, roots)
+		}
+	}
+	return tlsConfig, nil
+}
+
+// verifyPeerCertificates performs the verification InsecureSkipVerify turned off.
+func verifyPeerCertificates(cs tls.ConnectionState, roots *x509.CertPool) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("server presented no certificates")
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range cs.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := cs.PeerCertificates[0].Verify(x509.VerifyOptions{
+		DNSName:       cs.ServerName,
+		Roots:         roots,
+		Intermediates: intermediates,
+	})
+	return err
+}
+
+// isDefaultDomainHost reports whether host is the default domain or one of its subdomains.
+func isDefaultDomainHost(host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	return host == defaultDomain || strings.HasSuffix(host, "."+defaultDomain)
+}
+
+// validateProxyURL accepts absolute http, https and socks5 proxy URLs.
+func validateProxyURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return fmt.Errorf("%q must use http, https or socks5", raw)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("%q has no host", raw)
+	}
+	return nil
+}
@@ -0,0 +1,232 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// serverCertPEM returns the PEM encoded certificate of a TLS test server.
+func serverCertPEM(srv *httptest.Server) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+}
+
+// selfSignedKeyPair returns a PEM certificate and key usable as a TLS client certificate.
+func selfSignedKeyPair(t *testing.T) (certPEM, keyPEM []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %s", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %s", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key: %s", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestNewHTTPTransport_CABundle(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	defer srv.Close()
+
+	transport, err := newHTTPTransport(transportConfig{caBundlePEM: serverCertPEM(srv)})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+	if err != nil {
+		t.Fatalf("expected CA bundle to be trusted: %s", err)
+	}
+	resp.Body.Close()
+
+	if _, err := newHTTPTransport(transportConfig{caBundlePEM: []byte("not pem")}); err == nil {
+		t.Fatalf("expected error for CA bundle without certificates")
+	}
+}
+
+func TestNewHTTPTransport_ClientCertificate(t *testing.T) {
+	certPEM, keyPEM := selfSignedKeyPair(t)
+
+	var gotClientCert bool
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		gotClientCert = len(r.TLS.PeerCertificates) == 1
+	}))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	srv.StartTLS()
+	defer srv.Close()
+
+	transport, err := newHTTPTransport(transportConfig{caBundlePEM: serverCertPEM(srv), clientCertPEM: certPEM, clientKeyPEM: keyPEM})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+	if err != nil {
+		t.Fatalf("request: %s", err)
+	}
+	resp.Body.Close()
+	if !gotClientCert {
+		t.Fatalf("expected client certificate to be presented")
+	}
+
+	if _, err := newHTTPTransport(transportConfig{clientCertPEM: certPEM}); err == nil {
+		t.Fatalf("expected error for certificate without key")
+	}
+	if _, err := newHTTPTransport(transportConfig{clientCertPEM: certPEM, clientKeyPEM: certPEM}); err == nil {
+		t.Fatalf("expected error for invalid key")
+	}
+}
+
+func TestNewHTTPTransport_InsecureStillVerifiesDefaultDomain(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	defer srv.Close()
+
+	transport, err := newHTTPTransport(transportConfig{insecure: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+	if err != nil {
+		t.Fatalf("expected self-signed certificate to be accepted: %s", err)
+	}
+	resp.Body.Close()
+
+	state := tls.ConnectionState{ServerName: "iam.management.global." + defaultDomain, PeerCertificates: []*x509.Certificate{srv.Certificate()}}
+	if err := transport.TLSClientConfig.VerifyConnection(state); err == nil {
+		t.Fatalf("expected verification for %s", state.ServerName)
+	}
+	state.ServerName = "iam.internal.example.com"
+	if err := transport.TLSClientConfig.VerifyConnection(state); err != nil {
+		t.Fatalf("expected no verification for custom domain, got %s", err)
+	}
+}
+
+func TestIsDefaultDomainHost(t *testing.T) {
+	for host, want := range map[string]bool{
+		defaultDomain:                            true,
+		"IAM.management.global." + defaultDomain: true,
+		defaultDomain + ".":                      true,
+		"not" + defaultDomain:                    false,
+		defaultDomain + ".example.com":           false,
+	} {
+		if got := isDefaultDomainHost(host); got != want {
+			t.Fatalf("%q: expected %v, got %v", host, want, got)
+		}
+	}
+}
+
+func TestNewHTTPTransport_ProxyURLHonorsNoProxy(t *testing.T) {
+	t.Setenv("NO_PROXY", "internal.example.com")
+	transport, err := newHTTPTransport(transportConfig{proxyURL: "http://proxy.example.com:3128"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	proxyFor := func(rawURL string) string {
+		t.Helper()
+		u, _ := url.Parse(rawURL)
+		proxy, err := transport.Proxy(&http.Request{URL: u})
+		if err != nil {
+			t.Fatalf("proxy: %s", err)
+		}
+		if proxy == nil {
+			return ""
+		}
+		return proxy.String()
+	}
+	if got := proxyFor("https://iam.management.global.vidos.id/api-keys"); got != "http://proxy.example.com:3128" {
+		t.Fatalf("expected proxy, got %q", got)
+	}
+	if got := proxyFor("https://iam.internal.example.com/api-keys"); got != "" {
+		t.Fatalf("expected NO_PROXY host to bypass the proxy, got %q", got)
+	}
+}
+
+func TestResolveHTTPTransport(t *testing.T) {
+	for _, env := range []string{"VIDOS_CA_BUNDLE", "VIDOS_CLIENT_CERTIFICATE_FILE", "VIDOS_CLIENT_KEY_FILE", "VIDOS_PROXY_URL", "VIDOS_INSECURE"} {
+		t.Setenv(env, "")
+	}
+
+	transport, diags := resolveHTTPTransport(providerModel{}, defaultDomain, nil)
+	if diags.HasError() || transport != nil {
+		t.Fatalf("expected default transport without settings, got %v %#v", transport, diags)
+	}
+
+	certPEM, keyPEM := selfSignedKeyPair(t)
+	dir := t.TempDir()
+	for name, content := range map[string][]byte{"ca.pem": certPEM, "client.pem": certPEM, "client.key": keyPEM} {
+		if err := os.WriteFile(filepath.Join(dir, name), content, 0o600); err != nil {
+			t.Fatalf("write %s: %s", name, err)
+		}
+	}
+	t.Setenv("VIDOS_CA_BUNDLE", filepath.Join(dir, "ca.pem"))
+	transport, diags = resolveHTTPTransport(providerModel{
+		ClientCertificateFile: types.StringValue(filepath.Join(dir, "client.pem")),
+		ClientKeyFile:         types.StringValue(filepath.Join(dir, "client.key")),
+		ProxyURL:              types.StringValue("http://proxy.example.com:3128"),
+	}, defaultDomain, nil)
+	if diags.HasError() || transport == nil || transport.TLSClientConfig.RootCAs == nil || len(transport.TLSClientConfig.Certificates) != 1 {
+		t.Fatalf("expected TLS settings from files, got %#v", diags)
+	}
+	t.Setenv("VIDOS_CA_BUNDLE", "")
+
+	assertErrorAt := func(diags diag.Diagnostics, want path.Path) {
+		t.Helper()
+		if !diags.HasError() {
+			t.Fatalf("expected error at %s", want)
+		}
+		withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+		if !ok || !withPath.Path().Equal(want) {
+			t.Fatalf("expected error at %s, got %#v", want, diags.Errors()[0])
+		}
+	}
+
+	_, diags = resolveHTTPTransport(providerModel{CABundle: types.StringValue(string(certPEM)), CABundleFile: types.StringValue("ca.pem")}, defaultDomain, nil)
+	assertErrorAt(diags, path.Root("ca_bundle"))
+
+	_, diags = resolveHTTPTransport(providerModel{CABundleFile: types.StringValue(filepath.Join(dir, "missing.pem"))}, defaultDomain, nil)
+	assertErrorAt(diags, path.Root("ca_bundle_file"))
+
+	_, diags = resolveHTTPTransport(providerModel{ProxyURL: types.StringValue("proxy.example.com:3128")}, defaultDomain, nil)
+	assertErrorAt(diags, path.Root("proxy_url"))
+
+	t.Setenv("VIDOS_INSECURE", "maybe")
+	_, diags = resolveHTTPTransport(providerModel{}, "staging.example.com", nil)
+	assertErrorAt(diags, path.Root("insecure"))
+
+	t.Setenv("VIDOS_INSECURE", "true")
+	transport, diags = resolveHTTPTransport(providerModel{}, "staging.example.com", nil)
+	if diags.HasError() || diags.WarningsCount() != 0 || transport == nil || !transport.TLSClientConfig.InsecureSkipVerify {
+		t.Fatalf("expected insecure transport for custom domain, got %#v", diags)
+	}
+	_, diags = resolveHTTPTransport(providerModel{}, defaultDomain, nil)
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("expected a warning for insecure with only default domain hosts, got %#v", diags)
+	}
+}
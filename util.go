@@ -42,3 +42,10 @@ func parseJSONToAny(diags *diag.Diagnostics, raw string, attrPath path.Path, nam
 	}
 	return out
 }
+
+func titleCase(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -66,3 +66,12 @@ func TestParseJSONToAny_ValidJSON(t *testing.T) {
 		t.Fatalf("unexpected value: %#v", m["a"])
 	}
 }
+
+func TestTitleCase(t *testing.T) {
+	if got := titleCase("gateway"); got != "Gateway" {
+		t.Fatalf("unexpected value: %q", got)
+	}
+	if got := titleCase(""); got != "" {
+		t.Fatalf("unexpected value: %q", got)
+	}
+}
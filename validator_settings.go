@@ -0,0 +1,59 @@
+package main
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// trustAnchorTypes are the supported trusted issuer root certificate sources.
+var trustAnchorTypes = []string{"predefined", "pem"}
+
+// validatorSettingsSchemaAttribute is the typed alternative to the validator configuration JSON:
+//
+//	{"policies": {"trustedIssuer": {"skip", "trustedIssuerRootCertificates": [{"type", "tag"|"pem"}]}}}
+func validatorSettingsSchemaAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"policies": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Validation policies.",
+				Attributes: map[string]schema.Attribute{
+					"trusted_issuer": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "Requires credential issuers to chain to a trusted root.",
+						Attributes: map[string]schema.Attribute{
+							"skip": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether the policy is skipped.",
+							},
+							"trusted_issuer_root_certificates": schema.ListNestedAttribute{
+								Optional:    true,
+								Description: "Trust anchors: predefined sets by tag, or PEM-encoded root certificates.",
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"type": schema.StringAttribute{
+											Required:    true,
+											Description: "Trust anchor source: predefined or pem.",
+											Validators:  []validator.String{stringOneOfValidator{values: trustAnchorTypes}},
+										},
+										"tag": schema.StringAttribute{
+											Optional:    true,
+											Description: "Predefined trust anchor set (e.g. vidos). Required when type is predefined.",
+										},
+										"pem": schema.StringAttribute{
+											Optional:    true,
+											Description: "PEM-encoded X.509 root certificate. Required when type is pem.",
+										},
+									},
+									Validators: []validator.Object{trustAnchorValidator{}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -1,10 +1,21 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ validator.String = (*regionValidator)(nil)
@@ -39,3 +50,314 @@ func (v regionValidator) ValidateString(ctx context.Context, req validator.Strin
 		return
 	}
 }
+
+var _ validator.String = (*domainValidator)(nil)
+
+type domainValidator struct{}
+
+func (v domainValidator) Description(_ context.Context) string {
+	return "Domain must be a bare host name like vidos.id (no scheme, port or path)."
+}
+
+func (v domainValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v domainValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := validateDomain(strings.TrimSpace(req.ConfigValue.ValueString())); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid domain", err.Error())
+	}
+}
+
+var domainRegex = regexp.MustCompile(`^[a-z0-9](?:[a-z0-9-]*[a-z0-9])?(?:\.[a-z0-9](?:[a-z0-9-]*[a-z0-9])?)*$`)
+
+func validateDomain(s string) error {
+	if !domainRegex.MatchString(s) {
+		return fmt.Errorf("%q is not a valid domain; expected a lowercase host name such as vidos.id without scheme, port or path", s)
+	}
+	return nil
+}
+
+var _ validator.String = (*endpointValidator)(nil)
+
+type endpointValidator struct{}
+
+func (v endpointValidator) Description(_ context.Context) string {
+	return "Endpoint must be an absolute http or https URL such as https://iam.staging.example.com."
+}
+
+func (v endpointValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v endpointValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := validateEndpointURL(strings.TrimSpace(req.ConfigValue.ValueString())); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid endpoint", err.Error())
+	}
+}
+
+// validateEndpointURL requires an absolute http(s) URL without query or fragment; a path prefix
+// is allowed so endpoints can sit behind a reverse proxy.
+func validateEndpointURL(s string) error {
+	u, err := url.Parse(s)
+	if err != nil {
+		return fmt.Errorf("%q is not a valid URL: %s", s, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("%q must use http or https", s)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("%q has no host", s)
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("%q must not contain a query or fragment", s)
+	}
+	return nil
+}
+
+var _ validator.String = (*instanceStatusValidator)(nil)
+
+type instanceStatusValidator struct{}
+
+func (v instanceStatusValidator) Description(_ context.Context) string {
+	return "Status must be one of: " + strings.Join(instanceDesiredStatuses, ", ") + "."
+}
+
+func (v instanceStatusValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v instanceStatusValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	for _, s := range instanceDesiredStatuses {
+		if value == s {
+			return
+		}
+	}
+	resp.Diagnostics.AddAttributeError(req.Path, "Invalid status", v.Description(ctx))
+}
+
+var _ validator.String = (*stringOneOfValidator)(nil)
+
+// stringOneOfValidator requires an exact match against a fixed set of values. It backs the
+// enumerated fields of the typed settings schemas so typos fail at plan time.
+type stringOneOfValidator struct {
+	values []string
+}
+
+func (v stringOneOfValidator) Description(_ context.Context) string {
+	return "Value must be one of: " + strings.Join(v.values, ", ") + "."
+}
+
+func (v stringOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringOneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	for _, s := range v.values {
+		if value == s {
+			return
+		}
+	}
+	resp.Diagnostics.AddAttributeError(req.Path, "Invalid value", fmt.Sprintf("%q is not supported. %s", value, v.Description(ctx)))
+}
+
+var _ validator.String = (*durationValidator)(nil)
+
+// durationValidator accepts Go duration strings (720h, 90m) plus whole days (90d), which is
+// how rotation periods are usually written.
+type durationValidator struct{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "Value must be a positive duration such as 90d, 720h or 30m."
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := parseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid duration", err.Error())
+		return
+	}
+	if d <= 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid duration", v.Description(ctx))
+	}
+}
+
+// parseDuration parses a Go duration string, additionally accepting a whole number of days ("90d").
+func parseDuration(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: days must be a whole number", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: %s", s, strings.TrimPrefix(err.Error(), "time: "))
+	}
+	return d, nil
+}
+
+var _ validator.Object = (*trustAnchorValidator)(nil)
+
+// trustAnchorValidator checks that a trusted issuer root certificate carries the field its
+// type requires, and that PEM anchors hold exactly one parseable X.509 certificate.
+type trustAnchorValidator struct{}
+
+func (v trustAnchorValidator) Description(_ context.Context) string {
+	return "predefined anchors require tag; pem anchors require pem holding one PEM-encoded X.509 certificate."
+}
+
+func (v trustAnchorValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v trustAnchorValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attrs := req.ConfigValue.Attributes()
+	anchorType, _ := attrs["type"].(types.String)
+	tag, _ := attrs["tag"].(types.String)
+	pemValue, _ := attrs["pem"].(types.String)
+	if anchorType.IsNull() || anchorType.IsUnknown() {
+		return
+	}
+
+	required, unexpected := "tag", "pem"
+	if anchorType.ValueString() == "pem" {
+		required, unexpected = "pem", "tag"
+	}
+	byName := map[string]types.String{"tag": tag, "pem": pemValue}
+	if byName[required].IsNull() {
+		resp.Diagnostics.AddAttributeError(req.Path.AtName(required), "Missing "+required, fmt.Sprintf("%s is required when type is %q.", required, anchorType.ValueString()))
+	}
+	if !byName[unexpected].IsNull() {
+		resp.Diagnostics.AddAttributeError(req.Path.AtName(unexpected), "Unexpected "+unexpected, fmt.Sprintf("%s is not used when type is %q.", unexpected, anchorType.ValueString()))
+	}
+
+	if anchorType.ValueString() != "pem" || pemValue.IsNull() || pemValue.IsUnknown() {
+		return
+	}
+	if err := parsePEMCertificate(pemValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path.AtName("pem"), "Invalid certificate", err.Error())
+	}
+}
+
+// parsePEMCertificate requires exactly one PEM CERTIFICATE block holding a valid X.509 certificate.
+func parsePEMCertificate(s string) error {
+	block, rest := pem.Decode([]byte(strings.TrimSpace(s)))
+	if block == nil {
+		return errors.New("no PEM block found; expected -----BEGIN CERTIFICATE-----")
+	}
+	if block.Type != "CERTIFICATE" {
+		return fmt.Errorf("PEM block type is %q, expected CERTIFICATE", block.Type)
+	}
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return fmt.Errorf("certificate could not be parsed: %s", err)
+	}
+	if len(bytes.TrimSpace(rest)) > 0 {
+		return errors.New("expected exactly one certificate; add further roots as separate entries")
+	}
+	return nil
+}
+
+var _ validator.Int64 = (*int64AtLeastValidator)(nil)
+
+type int64AtLeastValidator struct {
+	min int64
+}
+
+func (v int64AtLeastValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Value must be at least %d.", v.min)
+}
+
+func (v int64AtLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64AtLeastValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if req.ConfigValue.ValueInt64() < v.min {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid value", fmt.Sprintf("%d is too small. %s", req.ConfigValue.ValueInt64(), v.Description(ctx)))
+	}
+}
+
+var _ validator.Float64 = (*float64PositiveValidator)(nil)
+
+type float64PositiveValidator struct{}
+
+func (v float64PositiveValidator) Description(_ context.Context) string {
+	return "Value must be a positive number."
+}
+
+func (v float64PositiveValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v float64PositiveValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueFloat64()
+	if !(value > 0) || math.IsInf(value, 0) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid value", fmt.Sprintf("%v is not supported. %s", value, v.Description(ctx)))
+	}
+}
+
+var _ validator.Set = (*statusCodeSetValidator)(nil)
+
+// statusCodeSetValidator accepts HTTP error status codes. 401 is excluded: a rejected key is
+// refreshed rather than retried.
+type statusCodeSetValidator struct{}
+
+func (v statusCodeSetValidator) Description(_ context.Context) string {
+	return "Values must be HTTP error status codes (400-599), except 401."
+}
+
+func (v statusCodeSetValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v statusCodeSetValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	for _, elem := range req.ConfigValue.Elements() {
+		code, ok := elem.(types.Int64)
+		if !ok || code.IsNull() || code.IsUnknown() {
+			continue
+		}
+		if c := code.ValueInt64(); c < 400 || c > 599 || c == 401 {
+			resp.Diagnostics.AddAttributeError(req.Path, "Invalid status code", fmt.Sprintf("%d is not supported. %s", c, v.Description(ctx)))
+		}
+	}
+}